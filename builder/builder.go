@@ -3,11 +3,15 @@ package builder
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os/exec"
+	"strings"
 
 	"github.com/blocklayerhq/bitcoinx/ui"
+	"github.com/blocklayerhq/bitcoinx/util"
+	"github.com/pkg/errors"
 )
 
 // Builder is a wrapper around `docker build` which provides a better UX.
@@ -20,7 +24,59 @@ type Builder struct {
 // BuildOpts contains a list of build options.
 type BuildOpts struct {
 	Verbose bool
+	// NoCache rebuilds the image from scratch, even if the sources didn't
+	// change since the last build.
 	NoCache bool
+
+	// Tags are the Go build tags of the application binaries.
+	Tags []string
+	// LDFlags are appended to the Go linker flags, e.g. to stamp the
+	// version with -X.
+	LDFlags string
+	// GOOS and GOARCH are the target platform of the application
+	// binaries. The binaries run within an image of the docker host
+	// platform, so they must match it: linux and the architecture of the
+	// docker daemon. They default to it.
+	GOOS   string
+	GOARCH string
+}
+
+// validatePlatform makes sure the binaries will be able to run within the
+// image, which is built for the platform of the docker daemon.
+func validatePlatform(ctx context.Context, opts BuildOpts) error {
+	if opts.GOOS != "" && opts.GOOS != "linux" {
+		return fmt.Errorf("unsupported GOOS %q: images only run linux binaries", opts.GOOS)
+	}
+	if opts.GOARCH == "" {
+		return nil
+	}
+	out, err := util.Output(ctx, "docker", "version", "--format", "{{.Server.Arch}}")
+	if err != nil {
+		return errors.Wrap(err, "unable to determine the docker architecture")
+	}
+	if arch := strings.TrimSpace(string(out)); opts.GOARCH != arch {
+		return fmt.Errorf("unsupported GOARCH %q: images are built for the docker architecture, %s", opts.GOARCH, arch)
+	}
+	return nil
+}
+
+// buildArgs returns the `docker build` arguments passing opts to the
+// Dockerfile.
+func (opts BuildOpts) buildArgs() []string {
+	args := []string{}
+	for _, arg := range []struct {
+		name, value string
+	}{
+		{"BUILD_TAGS", strings.Join(opts.Tags, ",")},
+		{"LDFLAGS", opts.LDFlags},
+		{"GOOS", opts.GOOS},
+		{"GOARCH", opts.GOARCH},
+	} {
+		if arg.value != "" {
+			args = append(args, "--build-arg", arg.name+"="+arg.value)
+		}
+	}
+	return args
 }
 
 // New creates a new Builder.
@@ -32,12 +88,27 @@ func New(rootDir, image string) *Builder {
 	}
 }
 
-// Build executes a build.
+// Build executes a build. The build is skipped if the image was already
+// built from the same sources and options, unless opts.NoCache is set.
 func (b *Builder) Build(ctx context.Context, opts BuildOpts) error {
+	if err := validatePlatform(ctx, opts); err != nil {
+		return err
+	}
+
+	hash, err := b.sourceHash(opts)
+	if err != nil {
+		ui.Warn("Unable to hash the sources, the build won't be cached: %v", err)
+	}
+	if hash != "" && !opts.NoCache && b.cached(ctx, hash) {
+		ui.Success("Build up to date")
+		return nil
+	}
+
 	args := []string{"build", "-t", b.image}
 	if opts.NoCache {
 		args = append(args, "--no-cache")
 	}
+	args = append(args, opts.buildArgs()...)
 	args = append(args, b.rootDir)
 	cmd := exec.CommandContext(ctx, "docker", args...)
 	outReader, err := cmd.StdoutPipe()
@@ -80,6 +151,12 @@ func (b *Builder) Build(ctx context.Context, opts BuildOpts) error {
 		return err
 	}
 
+	if hash != "" {
+		if err := b.saveCache(ctx, hash); err != nil {
+			ui.Warn("Unable to save the build cache: %v", err)
+		}
+	}
+
 	ui.Success("Build successful")
 	return nil
 }
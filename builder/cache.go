@@ -0,0 +1,131 @@
+package builder
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/blocklayerhq/bitcoinx/util"
+)
+
+// buildCache records the last successful build of a project, so that it can
+// be skipped when nothing changed.
+type buildCache struct {
+	// Hash covers the sources and the build options.
+	Hash string `json:"hash"`
+	// Image is the image name and ImageID the image it pointed to once
+	// built. Rebuilding or removing the image invalidates the cache.
+	Image   string `json:"image"`
+	ImageID string `json:"image_id"`
+}
+
+// cacheFile returns the path of the build cache, within the project state
+// directory.
+func (b *Builder) cacheFile() string {
+	return path.Join(b.rootDir, "state", "build-cache.json")
+}
+
+// cached returns true if the image was already built from the sources and
+// options matching hash.
+func (b *Builder) cached(ctx context.Context, hash string) bool {
+	data, err := ioutil.ReadFile(b.cacheFile())
+	if err != nil {
+		return false
+	}
+	cache := buildCache{}
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return false
+	}
+	if cache.Hash != hash || cache.Image != b.image {
+		return false
+	}
+	id, err := b.imageID(ctx)
+	return err == nil && id == cache.ImageID
+}
+
+// saveCache records that the image was built from hash.
+func (b *Builder) saveCache(ctx context.Context, hash string) error {
+	id, err := b.imageID(ctx)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(&buildCache{
+		Hash:    hash,
+		Image:   b.image,
+		ImageID: id,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path.Dir(b.cacheFile()), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(b.cacheFile(), data, 0644)
+}
+
+// imageID returns the ID of the image currently tagged with the image name.
+func (b *Builder) imageID(ctx context.Context) (string, error) {
+	out, err := util.Output(ctx, "docker", "image", "inspect", "--format", "{{.Id}}", b.image)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// sourceHash hashes the project sources along with the options affecting
+// the build output. The state and log directories, written while the
+// application runs, and the git metadata are left out.
+func (b *Builder) sourceHash(opts BuildOpts) (string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "image=%s\ntags=%s\nldflags=%s\ngoos=%s\ngoarch=%s\n",
+		b.image, strings.Join(opts.Tags, ","), opts.LDFlags, opts.GOOS, opts.GOARCH)
+
+	err := filepath.Walk(b.rootDir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(b.rootDir, p)
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			switch rel {
+			case ".git", "state", "log":
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		fmt.Fprintf(h, "%s\x00%v\x00", filepath.ToSlash(rel), fi.Mode())
+		if fi.Mode()&os.ModeSymlink != 0 {
+			target, err := os.Readlink(p)
+			if err != nil {
+				return err
+			}
+			io.WriteString(h, target)
+			return nil
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(h, f)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -42,7 +42,7 @@ func (p *Parser) processLine(text string, opts BuildOpts) {
 
 	// If we're in verbose mode, just print the line.
 	if opts.Verbose {
-		ui.Verbose(text)
+		ui.Verbose("%s", text)
 		return
 	}
 
@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/blocklayerhq/bitcoinx/discovery"
+	"github.com/blocklayerhq/bitcoinx/ui"
+)
+
+// reportBootstrap warns about the bootstrap peers that couldn't be reached,
+// once every connection has been attempted.
+func reportBootstrap(ctx context.Context, d *discovery.Server) {
+	results, err := d.BootstrapResults(ctx)
+	if err != nil {
+		return
+	}
+
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+			ui.Warn("Connection with bootstrap node %s failed: %v", r.Peer, r.Err)
+		}
+	}
+	if failed > 0 && failed < len(results) {
+		ui.Warn("Only connected to %d out of %d bootstrap peers", len(results)-failed, len(results))
+	}
+}
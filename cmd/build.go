@@ -2,6 +2,9 @@ package cmd
 
 import (
 	"context"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/blocklayerhq/chainkit/builder"
 	"github.com/blocklayerhq/chainkit/project"
@@ -14,7 +17,8 @@ var buildCmd = &cobra.Command{
 	Short: "Build the application",
 	Args:  cobra.ExactArgs(0),
 	Run: func(cmd *cobra.Command, args []string) {
-		ctx := context.Background()
+		requireCommands("docker")
+
 		verbose, err := cmd.Flags().GetBool("verbose")
 		if err != nil {
 			ui.Fatal("unable to resolve flag: %v", err)
@@ -23,6 +27,22 @@ var buildCmd = &cobra.Command{
 		if err != nil {
 			ui.Fatal("unable to resolve flag: %v", err)
 		}
+		tags, err := cmd.Flags().GetStringSlice("tags")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		ldflags, err := cmd.Flags().GetString("ldflags")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		goos, err := cmd.Flags().GetString("goos")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		goarch, err := cmd.Flags().GetString("goarch")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
 
 		rootDir := getCwd(cmd)
 		p, err := project.Load(rootDir)
@@ -30,13 +50,15 @@ var buildCmd = &cobra.Command{
 			ui.Fatal("%v", err)
 		}
 
-		b := builder.New(rootDir, p.Image)
 		opts := builder.BuildOpts{
 			Verbose: verbose,
 			NoCache: noCache,
+			Tags:    tags,
+			LDFlags: ldflags,
+			GOOS:    goos,
+			GOARCH:  goarch,
 		}
-		ui.Info("Building %s", ui.Emphasize(p.Name))
-		if err := b.Build(ctx, opts); err != nil {
+		if err := buildProject(rootDir, p, opts); err != nil {
 			ui.Fatal("Failed to build the application: %v", err)
 		}
 	},
@@ -46,6 +68,35 @@ func init() {
 	buildCmd.Flags().String("cwd", ".", "specifies the current working directory")
 	buildCmd.Flags().BoolP("verbose", "v", false, "enable verbose mode")
 	buildCmd.Flags().Bool("no-cache", false, "disable caching")
+	buildCmd.Flags().StringSlice("tags", nil, "Go build tags of the application binaries")
+	buildCmd.Flags().String("ldflags", "", "additional Go linker flags, e.g. \"-X main.version=1.0.0\"")
+	buildCmd.Flags().String("goos", "", "target operating system of the application binaries (only linux is supported)")
+	buildCmd.Flags().String("goarch", "", "target architecture of the application binaries (must match the docker host)")
 
 	rootCmd.AddCommand(buildCmd)
 }
+
+// buildProject builds the image of the project in rootDir. Interrupting the
+// command stops the build.
+func buildProject(rootDir string, p *project.Project, opts builder.BuildOpts) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(c)
+	go func() {
+		select {
+		case <-c:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ui.Info("Building %s", ui.Emphasize(p.Name))
+	if err := builder.New(rootDir, p.Image).Build(ctx, opts); err != nil {
+		return err
+	}
+	ui.Verbose("Built image %s", p.Image)
+	return nil
+}
@@ -18,6 +18,8 @@ var cliCmd = &cobra.Command{
 	Short:              "Run a command from the application CLI",
 	DisableFlagParsing: true,
 	Run: func(cmd *cobra.Command, args []string) {
+		requireCommands("docker")
+
 		p, err := project.Load(getCwd(cmd))
 		if err != nil {
 			ui.Fatal("%v", err)
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/blocklayerhq/bitcoinx/discovery"
+	"github.com/blocklayerhq/bitcoinx/ui"
+	"github.com/spf13/cobra"
+)
+
+// bashCompletionFunction completes the chain ID argument of the network
+// commands with the networks known locally.
+const bashCompletionFunction = `
+__custom_func() {
+    case ${last_command} in
+        bitcoinx_join | bitcoinx_status | bitcoinx_leave | bitcoinx_logs | bitcoinx_stop)
+            local networks
+            if networks=$(bitcoinx __networks 2>/dev/null); then
+                COMPREPLY=( $(compgen -W "${networks}" -- "$cur") )
+            fi
+            ;;
+    esac
+}
+`
+
+var completionCmd = &cobra.Command{
+	Use:   "completion <bash|zsh>",
+	Short: "Generate a shell completion script",
+	Long: `Generate a shell completion script.
+
+To load completions in the current bash shell:
+  source <(bitcoinx completion bash)
+
+To load completions in zsh, add the generated script to your $fpath:
+  bitcoinx completion zsh > "${fpath[1]}/_bitcoinx"`,
+	ValidArgs: []string{"bash", "zsh"},
+	Args:      cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var err error
+		switch args[0] {
+		case "bash":
+			err = rootCmd.GenBashCompletion(os.Stdout)
+		case "zsh":
+			err = rootCmd.GenZshCompletion(os.Stdout)
+		default:
+			ui.Fatal("Unsupported shell %q", args[0])
+		}
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+	},
+}
+
+// networksCmd prints the known chain IDs and aliases, one per line. It is
+// used by the completion scripts.
+var networksCmd = &cobra.Command{
+	Use:    "__networks",
+	Hidden: true,
+	Args:   cobra.ExactArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		d, err := discovery.New(path.Join(networksDir, "ipfs"), 0, discovery.Options{
+			AliasesFile: aliasesFile(),
+			IndexFile:   indexFile(),
+		})
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+		networks, err := d.KnownNetworks()
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+		for _, n := range networks {
+			fmt.Println(n.ChainID)
+			if n.Alias != "" {
+				fmt.Println(n.Alias)
+			}
+		}
+	},
+}
+
+func init() {
+	rootCmd.BashCompletionFunction = bashCompletionFunction
+
+	rootCmd.AddCommand(completionCmd)
+	rootCmd.AddCommand(networksCmd)
+}
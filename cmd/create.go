@@ -5,25 +5,58 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
 	"text/template"
+	"time"
+	"unicode"
 
 	"github.com/blocklayerhq/chainkit/builder"
 	"github.com/blocklayerhq/chainkit/httpfs"
 	"github.com/blocklayerhq/chainkit/project"
 	"github.com/blocklayerhq/chainkit/templates"
 	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/blocklayerhq/chainkit/util"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
+// templateContext holds the variables available to the templates, both in
+// file names and in the content of .tmpl files:
+//
+//	{{ .Name }}     name of the application
+//	{{ .GoName }}   CamelCase Go identifier derived from the name
+//	{{ .RootDir }}  directory the application is created in
+//	{{ .GoPkg }}    Go import path of the application
+//	{{ .Author }}   author of the application
+//	{{ .License }}  license of the application
+//	{{ .Year }}     current year
 type templateContext struct {
 	Name    string
+	GoName  string
 	RootDir string
 	GoPkg   string
+	Author  string
+	License string
+	Year    int
+}
+
+// createOptions are the options of the create command.
+type createOptions struct {
+	// Module is the Go import path of the application. When set, the
+	// application may be created outside GOPATH.
+	Module string
+	// TemplateDir is a local directory to extract the application from
+	// instead of the embedded templates.
+	TemplateDir string
+	// Author and License are exposed to the templates.
+	Author  string
+	License string
+	// NoBuild stops after scaffolding, without building the application.
+	NoBuild bool
 }
 
 var createCmd = &cobra.Command{
@@ -34,36 +67,91 @@ var createCmd = &cobra.Command{
 		name := args[0]
 		rootDir := path.Join(getCwd(cmd), name)
 		p := project.New(name)
-		create(rootDir, p)
+
+		module, err := cmd.Flags().GetString("module")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		templateDir, err := cmd.Flags().GetString("template-dir")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		author, err := cmd.Flags().GetString("author")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		if author == "" {
+			author = defaultAuthor()
+		}
+
+		license, err := cmd.Flags().GetString("license")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		if license == "" {
+			license = os.Getenv("BITCOINX_LICENSE")
+		}
+
+		noBuild, err := cmd.Flags().GetBool("no-build")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		if !noBuild {
+			requireCommands("docker")
+		}
+
+		create(rootDir, p, createOptions{
+			Module:      module,
+			TemplateDir: templateDir,
+			Author:      author,
+			License:     license,
+			NoBuild:     noBuild,
+		})
 	},
 }
 
 func init() {
 	createCmd.Flags().String("cwd", ".", "specifies the current working directory")
+	createCmd.Flags().String("module", "", "Go module path of the application, allows creating it outside GOPATH")
+	createCmd.Flags().String("template-dir", "", "extract the application from a local template directory instead of the built-in templates")
+	createCmd.Flags().String("author", "", "author of the application (defaults to $BITCOINX_AUTHOR, then the git user name)")
+	createCmd.Flags().String("license", "", "license of the application (defaults to $BITCOINX_LICENSE)")
+	createCmd.Flags().Bool("no-build", false, "only scaffold the application, without building it")
 
 	rootCmd.AddCommand(createCmd)
 }
 
-func create(rootDir string, p *project.Project) {
-	ctx := context.Background()
-
+func create(rootDir string, p *project.Project, opts createOptions) {
 	ui.Info("Creating a new blockchain app in %s", ui.Emphasize(rootDir))
 
-	if err := scaffold(rootDir, p); err != nil {
+	fs, err := templateFS(opts.TemplateDir)
+	if err != nil {
+		ui.Fatal("Failed to initialize: %v", err)
+	}
+	if err := scaffold(fs, rootDir, p, opts); err != nil {
 		ui.Fatal("Failed to initialize: %v", err)
 	}
 
-	ui.Info("Building %s", ui.Emphasize(p.Name))
-	b := builder.New(rootDir, p.Image)
-	if err := b.Build(ctx, builder.BuildOpts{}); err != nil {
-		ui.Fatal("Failed to build the application: %v", err)
+	if !opts.NoBuild {
+		if err := buildProject(rootDir, p, builder.BuildOpts{}); err != nil {
+			ui.Fatal("Failed to build the application: %v", err)
+		}
 	}
 
 	ui.Success("Success! Created %s at %s", ui.Emphasize(p.Name), ui.Emphasize(rootDir))
-	printGettingStarted(p)
+	printGettingStarted(p, !opts.NoBuild)
 }
 
-func printGettingStarted(p *project.Project) {
+// printGettingStarted prints the next steps. If the application hasn't been
+// built yet, building it is suggested first.
+func printGettingStarted(p *project.Project, built bool) {
+	next := "bitcoinx start"
+	if !built {
+		next = "bitcoinx build"
+	}
 	fmt.Printf(`
 Inside that directory, you can run several commands:
 
@@ -77,35 +165,51 @@ We suggest that you begin by typing:
   %s %s
   %s
 `,
-		ui.Emphasize("chainkit start"),
-		ui.Emphasize("chainkit build"),
+		ui.Emphasize("bitcoinx start"),
+		ui.Emphasize("bitcoinx build"),
 		ui.Emphasize("cd"),
 		p.Name,
-		ui.Emphasize("chainkit start"),
+		ui.Emphasize(next),
 	)
 }
 
-func scaffold(rootDir string, p *project.Project) error {
+// scaffold extracts the application templates from fs to rootDir. rootDir is
+// removed if extraction fails.
+func scaffold(fs http.FileSystem, rootDir string, p *project.Project, opts createOptions) error {
 	ui.Info("Scaffolding base application")
 
-	gosource := goSrc()
-
-	if !strings.HasPrefix(rootDir, gosource) {
-		return fmt.Errorf("you must run this command within your GOPATH (%q)", goPath())
+	goPkg, err := goPackage(rootDir, opts.Module)
+	if err != nil {
+		return err
 	}
 
-	// Make sure the destination path doesn't exist.
-	if _, err := os.Stat(rootDir); !os.IsNotExist(err) {
-		return fmt.Errorf("destination path %q already exists", rootDir)
+	// Make sure the destination path doesn't exist. Creating it here,
+	// rather than just checking, guarantees the directory is ours to clean
+	// up if scaffolding fails.
+	if err := os.MkdirAll(path.Dir(rootDir), 0755); err != nil {
+		return err
+	}
+	if err := os.Mkdir(rootDir, 0755); err != nil {
+		if os.IsExist(err) {
+			return fmt.Errorf("destination path %q already exists", rootDir)
+		}
+		return err
 	}
 
 	ctx := &templateContext{
 		Name:    p.Name,
+		GoName:  goName(p.Name),
 		RootDir: rootDir,
-		GoPkg:   strings.TrimPrefix(rootDir, gosource+"/"),
+		GoPkg:   goPkg,
+		Author:  opts.Author,
+		License: opts.License,
+		Year:    time.Now().Year(),
 	}
 
-	if err := extractFiles(ctx, rootDir, p); err != nil {
+	if err := extractFiles(fs, ctx, rootDir, p); err != nil {
+		if rmErr := os.RemoveAll(rootDir); rmErr != nil {
+			ui.Error("Unable to clean up %s: %v", rootDir, rmErr)
+		}
 		return err
 	}
 	if err := ui.Tree(rootDir, []string{"k8s"}); err != nil {
@@ -115,21 +219,87 @@ func scaffold(rootDir string, p *project.Project) error {
 	return nil
 }
 
-func extractFiles(ctx *templateContext, rootDir string, p *project.Project) error {
-	err := httpfs.Walk(templates.Assets, "/", func(path string, fi os.FileInfo, err error) error {
+// goPackage returns the Go import path of the application in rootDir. Without
+// an explicit module path, it is derived from the location within GOPATH.
+func goPackage(rootDir, module string) (string, error) {
+	if module != "" {
+		module = strings.Trim(module, "/")
+		if module == "" || strings.ContainsAny(module, " \t\\") {
+			return "", fmt.Errorf("invalid module path %q", module)
+		}
+		return module, nil
+	}
+
+	gosource := goSrc()
+	if !strings.HasPrefix(rootDir, gosource) {
+		return "", fmt.Errorf("you must run this command within your GOPATH (%q) or provide a module path with --module", goPath())
+	}
+	return strings.TrimPrefix(rootDir, gosource+"/"), nil
+}
+
+// defaultAuthor returns the author from $BITCOINX_AUTHOR or, failing that,
+// the git user name. Returns an empty string if neither is set.
+func defaultAuthor() string {
+	if author := os.Getenv("BITCOINX_AUTHOR"); author != "" {
+		return author
+	}
+	out, err := util.Output(context.Background(), "git", "config", "user.name")
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// goName converts name to a CamelCase Go identifier, e.g. "my-app" becomes
+// "MyApp".
+func goName(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var b strings.Builder
+	for _, w := range words {
+		r := []rune(w)
+		b.WriteString(strings.ToUpper(string(r[0])))
+		b.WriteString(string(r[1:]))
+	}
+	id := b.String()
+	if id == "" || unicode.IsDigit([]rune(id)[0]) {
+		id = "App" + id
+	}
+	return id
+}
+
+// templateFS returns the file system to extract the application from: the
+// given local directory, or the embedded templates if empty.
+func templateFS(dir string) (http.FileSystem, error) {
+	if dir == "" {
+		return templates.Assets, nil
+	}
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to access template directory")
+	}
+	if !fi.IsDir() {
+		return nil, fmt.Errorf("template path %q is not a directory", dir)
+	}
+	return http.Dir(dir), nil
+}
+
+func extractFiles(fs http.FileSystem, ctx *templateContext, rootDir string, p *project.Project) error {
+	err := httpfs.Walk(fs, "/", func(path string, fi os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
-		return extractFile(ctx, rootDir, path, p, fi)
+		return extractFile(fs, ctx, rootDir, path, p, fi)
 	})
 	return err
 }
 
-func extractFile(ctx *templateContext, rootDir, src string, p *project.Project, fi os.FileInfo) error {
+func extractFile(fs http.FileSystem, ctx *templateContext, rootDir, src string, p *project.Project, fi os.FileInfo) error {
 	// Templatize the file name.
 	parsedSrc, err := templatize(ctx, src, src)
 	if err != nil {
-		return err
+		return errors.Wrapf(err, "unable to templatize file name %q", src)
 	}
 
 	dstPath := path.Join(rootDir, string(parsedSrc))
@@ -142,17 +312,18 @@ func extractFile(ctx *templateContext, rootDir, src string, p *project.Project,
 		return errors.Wrap(err, "Failed to create chainkit.yml")
 	}
 
-	data, err := httpfs.ReadFile(templates.Assets, src)
+	data, err := httpfs.ReadFile(fs, src)
 	if err != nil {
 		return errors.Wrap(err, "unable to read template file")
 	}
 
 	// Handle templates
 	if filepath.Ext(dstPath) == ".tmpl" {
-		// Parse template
-		data, err = templatize(ctx, dstPath, string(data))
+		// Parse template. Naming it after the source makes errors
+		// report the template file and line.
+		data, err = templatize(ctx, src, string(data))
 		if err != nil {
-			return errors.Wrap(err, "unable to templetaize")
+			return errors.Wrapf(err, "unable to templatize %s", src)
 		}
 
 		// Remove .tpl from the file path
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/blocklayerhq/bitcoinx/project"
+)
+
+// failingFS fails to open a single file.
+type failingFS struct {
+	http.FileSystem
+	fail string
+}
+
+func (fs failingFS) Open(name string) (http.File, error) {
+	if name == fs.fail {
+		return nil, errors.New("injected failure")
+	}
+	return fs.FileSystem.Open(name)
+}
+
+// templateDir returns a template directory holding files.
+func templateDir(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "create-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	for name, content := range files {
+		if err := ioutil.WriteFile(path.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// scaffoldDir returns the path of an application to scaffold, which doesn't
+// exist yet.
+func scaffoldDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "create-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return path.Join(dir, "app")
+}
+
+func TestScaffoldCleanup(t *testing.T) {
+	fs := failingFS{
+		FileSystem: http.Dir(templateDir(t, map[string]string{
+			"a.txt": "a",
+			"b.txt": "b",
+			"c.txt": "c",
+		})),
+		fail: "/b.txt",
+	}
+	rootDir := scaffoldDir(t)
+
+	err := scaffold(fs, rootDir, project.New("app"), createOptions{Module: "example.com/app"})
+	if err == nil || !strings.Contains(err.Error(), "injected failure") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(rootDir); !os.IsNotExist(err) {
+		t.Fatalf("%s not removed: %v", rootDir, err)
+	}
+}
+
+func TestScaffoldTemplateError(t *testing.T) {
+	tests := map[string]string{
+		"parse":   "{{ .Name ",
+		"execute": "{{ .Missing }}",
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			fs := http.Dir(templateDir(t, map[string]string{
+				"main.go.tmpl": "package {{ .Name }}",
+				"broken.tmpl":  content,
+			}))
+			rootDir := scaffoldDir(t)
+
+			err := scaffold(fs, rootDir, project.New("app"), createOptions{Module: "example.com/app"})
+			if err == nil || !strings.Contains(err.Error(), "broken.tmpl") {
+				t.Fatalf("error doesn't name the template: %v", err)
+			}
+		})
+	}
+}
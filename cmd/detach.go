@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/blocklayerhq/bitcoinx/config"
+	"github.com/blocklayerhq/bitcoinx/node"
+	"github.com/blocklayerhq/bitcoinx/ui"
+)
+
+// detachTimeout is how long to wait for a detached node to come up.
+const detachTimeout = 10 * time.Minute
+
+// runDetached runs the current command again in the background, without
+// the --detach flag, and returns once the node is up. The result is printed
+// as JSON if jsonOutput is set.
+//
+// The background process keeps running the discovery server, so the node
+// keeps announcing itself and finding peers until it gets stopped.
+func runDetached(cfg *config.Config, jsonOutput bool) {
+	if err := os.MkdirAll(cfg.RootDir, 0755); err != nil {
+		ui.Fatal("%v", err)
+	}
+	out, err := os.OpenFile(cfg.DetachedLogFile(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		ui.Fatal("unable to open log file: %v", err)
+	}
+	defer out.Close()
+
+	// Remove a stale status file so we don't mistake it for the new node.
+	os.Remove(cfg.StatusFile())
+
+	args := []string{}
+	for _, arg := range os.Args[1:] {
+		if arg == "--detach" || strings.HasPrefix(arg, "--detach=") {
+			continue
+		}
+		args = append(args, arg)
+	}
+	child := exec.Command(os.Args[0], args...)
+	child.Stdout = out
+	child.Stderr = out
+	child.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	if err := child.Start(); err != nil {
+		ui.Fatal("Unable to start the node: %v", err)
+	}
+	if err := ioutil.WriteFile(cfg.PIDFile(), []byte(strconv.Itoa(child.Process.Pid)), 0644); err != nil {
+		ui.Fatal("Unable to write pid file: %v", err)
+	}
+
+	exitCh := make(chan error, 1)
+	go func() {
+		exitCh <- child.Wait()
+	}()
+
+	deadline := time.After(detachTimeout)
+	for {
+		if status, err := node.ReadStatus(cfg); err == nil {
+			ui.Success("Node running in the background for network %s", ui.Emphasize(status.ChainID))
+			ui.Success("  Application is live at    : %s", ui.Emphasize(rpcURL(status)))
+			ui.Success("  Output can be found in    : %s", ui.Emphasize(cfg.DetachedLogFile()))
+			if jsonOutput {
+				printResult(newNetworkResult(status, ""))
+			}
+			return
+		}
+
+		ui.Live("Waiting for the node to start")
+		select {
+		case <-exitCh:
+			os.Remove(cfg.PIDFile())
+			ui.Fatal("The node failed to start, see %s", cfg.DetachedLogFile())
+		case <-deadline:
+			ui.Fatal("The node did not start after %v, see %s", detachTimeout, cfg.DetachedLogFile())
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// stopDetached stops the background process of a detached node, if any.
+// Returns true if a process was signaled.
+func stopDetached(cfg *config.Config) bool {
+	data, err := ioutil.ReadFile(cfg.PIDFile())
+	if err != nil {
+		return false
+	}
+	defer os.Remove(cfg.PIDFile())
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return false
+	}
+	return syscall.Kill(pid, syscall.SIGTERM) == nil
+}
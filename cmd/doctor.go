@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/blocklayerhq/bitcoinx/config"
+	"github.com/blocklayerhq/bitcoinx/discovery"
+	"github.com/blocklayerhq/bitcoinx/ui"
+	"github.com/blocklayerhq/bitcoinx/util"
+	"github.com/spf13/cobra"
+)
+
+// doctorTimeout bounds every command run by the doctor.
+const doctorTimeout = 10 * time.Second
+
+// doctorCheck is a single diagnostic. run returns nil if the check passed.
+type doctorCheck struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the local environment for common problems",
+	Args:  cobra.ExactArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		fixLock, err := cmd.Flags().GetBool("fix-lock")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		checks := []doctorCheck{
+			{"Docker installed", checkDockerInstalled},
+			{"Docker running", checkDockerRunning},
+			{"GOPATH", checkGOPATH},
+			{"Networks directory", func(context.Context) error {
+				return ensureWritableDir(networksDir)
+			}},
+			{"Ports", func(context.Context) error {
+				return checkPorts(getCwd(cmd))
+			}},
+		}
+		for _, dir := range ipfsRepos(getCwd(cmd)) {
+			dir := dir
+			checks = append(checks, doctorCheck{
+				name: "IPFS repository " + dir,
+				run: func(context.Context) error {
+					return checkRepoLock(dir, fixLock)
+				},
+			})
+		}
+
+		failed := 0
+		for _, check := range checks {
+			if err := check.run(ctx); err != nil {
+				ui.Error("%s: %v", check.name, err)
+				failed++
+				continue
+			}
+			ui.Success("%s", check.name)
+		}
+
+		if failed > 0 {
+			ui.Fatal("%d of %d checks failed", failed, len(checks))
+		}
+		ui.Info("All checks passed")
+	},
+}
+
+func init() {
+	doctorCmd.Flags().String("cwd", ".", "specifies the current working directory")
+	doctorCmd.Flags().Bool("fix-lock", false, "remove the IPFS repository locks left over by crashed processes")
+
+	rootCmd.AddCommand(doctorCmd)
+}
+
+func checkDockerInstalled(ctx context.Context) error {
+	return util.RequireCommands("docker")
+}
+
+func checkDockerRunning(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, doctorTimeout)
+	defer cancel()
+	if _, err := util.Output(ctx, "docker", "info"); err != nil {
+		return fmt.Errorf("unable to reach the docker daemon: %v", err)
+	}
+	return nil
+}
+
+// checkGOPATH makes sure GOPATH points to a directory, if go is installed.
+// Applications are built within containers, so go itself is optional.
+func checkGOPATH(ctx context.Context) error {
+	if err := util.RequireCommands("go"); err != nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, doctorTimeout)
+	defer cancel()
+	out, err := util.Output(ctx, "go", "env", "GOPATH")
+	if err != nil {
+		return err
+	}
+	for _, dir := range filepath.SplitList(strings.TrimSpace(string(out))) {
+		fi, err := os.Stat(dir)
+		if err != nil {
+			return fmt.Errorf("GOPATH entry %q: %v", dir, err)
+		}
+		if !fi.IsDir() {
+			return fmt.Errorf("GOPATH entry %q is not a directory", dir)
+		}
+	}
+	return nil
+}
+
+// checkPorts makes sure the ports of the project in rootDir are available,
+// or, if they were never allocated, that the default ports are.
+func checkPorts(rootDir string) error {
+	cfg := &config.Config{RootDir: rootDir}
+	ports, err := cfg.LoadPorts()
+	if err != nil {
+		return err
+	}
+	if ports == nil {
+		ports, err = config.AllocatePorts()
+		if err != nil {
+			return err
+		}
+		ports.Release()
+	}
+	if err := ports.Validate(ports.Explorer != 0); err != nil {
+		return fmt.Errorf("%v (is the node already running?)", err)
+	}
+	return nil
+}
+
+// checkRepoLock makes sure no other process holds the IPFS repository at
+// dir. If fix is set, a stale lock gets removed.
+func checkRepoLock(dir string, fix bool) error {
+	stale, err := discovery.RepoLockStale(dir)
+	if err != nil {
+		return err
+	}
+	if stale {
+		if !fix {
+			return fmt.Errorf("stale lock left over by a process which is gone, run with --fix-lock to remove it")
+		}
+		if _, err := discovery.RemoveStaleLock(dir); err != nil {
+			return fmt.Errorf("unable to remove the stale lock: %v", err)
+		}
+		ui.Info("Removed the stale lock of %s", dir)
+	}
+
+	locked, err := discovery.RepoLocked(dir)
+	if err != nil {
+		return err
+	}
+	if locked {
+		return fmt.Errorf("locked by another process (is the node already running?)")
+	}
+	return nil
+}
+
+// ipfsRepos returns the existing IPFS repositories: the one of the project
+// in rootDir, the shared one of the networks directory and the ones of the
+// joined networks.
+func ipfsRepos(rootDir string) []string {
+	candidates := []string{
+		(&config.Config{RootDir: rootDir}).IPFSDir(),
+		path.Join(networksDir, "ipfs"),
+	}
+	joined, _ := filepath.Glob(path.Join(networksDir, "*", "state", "ipfs"))
+	candidates = append(candidates, joined...)
+
+	repos := []string{}
+	for _, dir := range candidates {
+		if fi, err := os.Stat(dir); err == nil && fi.IsDir() {
+			repos = append(repos, dir)
+		}
+	}
+	return repos
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path"
+
+	"github.com/blocklayerhq/bitcoinx/config"
+	"github.com/blocklayerhq/bitcoinx/discovery"
+	"github.com/blocklayerhq/bitcoinx/ui"
+	"github.com/spf13/cobra"
+)
+
+var fetchCmd = &cobra.Command{
+	Use:   "fetch <chain-id|alias>",
+	Short: "Download the files of a network without running a node",
+	Long: `Download the genesis file of a network, and optionally its manifest and
+container image, into a directory. Neither the node nor the explorer are
+started, which makes it handy to inspect a network or to seed other tools.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		outDir, err := cmd.Flags().GetString("out")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		withManifest, err := cmd.Flags().GetBool("manifest")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		withImage, err := cmd.Flags().GetBool("image")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		bootstrapPeers, err := cmd.Flags().GetStringSlice("bootstrap")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		peers, err := cmd.Flags().GetStringSlice("peer")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		timeout, err := cmd.Flags().GetDuration("timeout")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		chainID, err := resolveAlias(args[0])
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+		if err := config.ValidateChainID(chainID); err != nil {
+			ui.Fatal("%v", err)
+		}
+		if err := os.MkdirAll(outDir, 0755); err != nil {
+			ui.Fatal("Unable to create %s: %v", outDir, err)
+		}
+
+		ports, err := config.AllocatePorts()
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+		ports.Release()
+		// Networks fetched here aren't joined: keep them out of the index
+		// of known networks.
+		d, err := discovery.New(path.Join(networksDir, "ipfs"), ports.IPFS, discovery.Options{
+			BootstrapPeers: bootstrapPeers,
+			AliasesFile:    aliasesFile(),
+		})
+		if err != nil {
+			ui.Fatal("Failed to initialize discovery: %v", err)
+		}
+		if err := d.Start(ctx); err != nil {
+			if err == discovery.ErrNotConnected {
+				reportBootstrap(ctx, d)
+			}
+			if err != discovery.ErrNotConnected || len(peers) == 0 {
+				ui.Fatal("Failed to initialize discovery: %v", err)
+			}
+			ui.Warn("Unable to connect to the bootstrap peers, relying on --peer")
+		}
+		defer d.Stop()
+		for _, peer := range peers {
+			if err := d.AddPeer(ctx, peer); err != nil {
+				ui.Fatal("%v", err)
+			}
+		}
+
+		ui.Info("Retrieving network information...")
+		network, err := d.Join(ctx, chainID)
+		if err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				ui.Fatal("Timed out fetching network %s after %s", chainID, timeout)
+			}
+			ui.Fatal("Unable to retrieve network information for %q: %v", chainID, err)
+		}
+		defer network.Close()
+
+		genesisPath := path.Join(outDir, "genesis.json")
+		if err := network.WriteGenesis(genesisPath); err != nil {
+			ui.Fatal("%v", err)
+		}
+		ui.Success("Genesis written to %s", ui.Emphasize(genesisPath))
+		ui.Verbose("Genesis hash: %s", network.GenesisHash())
+
+		if withManifest {
+			manifestPath := path.Join(outDir, "chainkit.yml")
+			if err := network.WriteManifest(manifestPath); err != nil {
+				ui.Fatal("%v", err)
+			}
+			ui.Success("Manifest written to %s", ui.Emphasize(manifestPath))
+		}
+		if withImage {
+			imagePath := path.Join(outDir, "image.tar")
+			if err := network.WriteImage(imagePath); err != nil {
+				ui.Fatal("%v", err)
+			}
+			ui.Success("Image written to %s", ui.Emphasize(imagePath))
+		}
+	},
+}
+
+func init() {
+	fetchCmd.Flags().StringP("out", "O", ".", "directory to write the network files to")
+	fetchCmd.Flags().Bool("manifest", false, "also write the network manifest (chainkit.yml)")
+	fetchCmd.Flags().Bool("image", false, "also write the container image tarball of the network (image.tar)")
+	fetchCmd.Flags().StringSlice("bootstrap", nil, "IPFS bootstrap peers to use instead of the defaults")
+	fetchCmd.Flags().StringSlice("peer", nil, "multiaddr of a node of the network to connect to directly")
+	fetchCmd.Flags().Duration("timeout", 0, "maximum duration of the download (no limit if 0)")
+
+	rootCmd.AddCommand(fetchCmd)
+}
@@ -2,25 +2,173 @@ package cmd
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"os"
 	"os/signal"
 	"path"
 	"path/filepath"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/blocklayerhq/bitcoinx/config"
 	"github.com/blocklayerhq/bitcoinx/discovery"
 	"github.com/blocklayerhq/bitcoinx/node"
 	"github.com/blocklayerhq/bitcoinx/ui"
+	"github.com/blocklayerhq/bitcoinx/util"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
 var (
-	networksDir = os.ExpandEnv("$HOME/.bitcoinx/networks")
+	configFile  = path.Join(config.ConfigHome(), "config.yaml")
+	networksDir = path.Join(config.DataHome(), "networks")
+
+	// defaults is the configuration file, loaded before running any
+	// command.
+	defaults = &config.Config{}
 )
 
+// loadConfig loads the configuration file given by the --config flag, then
+// resolves networksDir.
+func loadConfig(cmd *cobra.Command) error {
+	configPath, err := cmd.Flags().GetString("config")
+	if err != nil {
+		return err
+	}
+	if defaults, err = config.Load(configPath); err != nil {
+		return err
+	}
+	return resolveNetworksDir(cmd)
+}
+
+// resolveNetworksDir sets networksDir from the --networks-dir flag, the
+// BITCOINX_NETWORKS_DIR environment variable or the networks_dir setting of
+// the configuration file, in that order of precedence.
+func resolveNetworksDir(cmd *cobra.Command) error {
+	dir, err := cmd.Flags().GetString("networks-dir")
+	if err != nil {
+		return err
+	}
+	if dir == "" {
+		dir = os.Getenv("BITCOINX_NETWORKS_DIR")
+	}
+	if dir == "" {
+		dir = defaults.NetworksDir
+	}
+	if dir == "" {
+		return nil
+	}
+
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return fmt.Errorf("unable to parse %q: %v", dir, err)
+	}
+	networksDir = abs
+	return nil
+}
+
+// ensureWritableDir creates dir if needed and makes sure it is writable.
+func ensureWritableDir(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("unable to create networks directory %q: %v", dir, err)
+	}
+	f, err := ioutil.TempFile(dir, ".write-test")
+	if err != nil {
+		return fmt.Errorf("networks directory %q is not writable: %v", dir, err)
+	}
+	f.Close()
+	return os.Remove(f.Name())
+}
+
+// aliasesFile returns the path of the network aliases store.
+func aliasesFile() string {
+	return path.Join(networksDir, "aliases.json")
+}
+
+// indexFile returns the path of the known networks index.
+func indexFile() string {
+	return path.Join(networksDir, "index.json")
+}
+
+// explorerImage returns the explorer image to use. The --explorer-image flag
+// takes precedence over the BITCOINX_EXPLORER_IMAGE environment variable,
+// which takes precedence over fallback.
+func explorerImage(cmd *cobra.Command, fallback string) string {
+	if cmd.Flags().Changed("explorer-image") {
+		image, err := cmd.Flags().GetString("explorer-image")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		return image
+	}
+	if image := os.Getenv("BITCOINX_EXPLORER_IMAGE"); image != "" {
+		return image
+	}
+	if fallback != "" {
+		return fallback
+	}
+	return config.ExplorerImageDefault
+}
+
+// resolveAlias returns the chain ID registered for name, or name itself if
+// it isn't a known alias.
+func resolveAlias(name string) (string, error) {
+	d, err := discovery.New(path.Join(networksDir, "ipfs"), 0, discovery.Options{
+		AliasesFile: aliasesFile(),
+		IndexFile:   indexFile(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return d.ResolveAlias(name)
+}
+
+// allocatePorts sets the ports of the node, reusing the ones saved by a
+// previous run if any so that clients can keep using them.
+func allocatePorts(cmd *cobra.Command, cfg *config.Config) {
+	ports, err := cfg.LoadPorts()
+	if err != nil {
+		ui.Fatal("%v", err)
+	}
+	if ports != nil {
+		cfg.Ports = ports
+		validatePorts(cfg)
+		ui.Verbose("Reusing ports %s", cfg.Ports)
+		return
+	}
+
+	basePort, err := cmd.Flags().GetInt("base-port")
+	if err != nil {
+		ui.Fatal("unable to resolve flag: %v", err)
+	}
+	cfg.Ports, err = config.AllocatePortsFrom(basePort, !cfg.DisableExplorer)
+	if err != nil {
+		ui.Fatal("%v", err)
+	}
+	if err := cfg.SavePorts(); err != nil {
+		ui.Fatal("%v", err)
+	}
+	ui.Verbose("Allocated ports %s", cfg.Ports)
+}
+
+// validatePorts makes sure the ports of the node are available. The explorer
+// port is only needed if the explorer is enabled.
+func validatePorts(cfg *config.Config) {
+	if !cfg.DisableExplorer {
+		cfg.Ports.EnableExplorer()
+	}
+	if err := cfg.Ports.Validate(!cfg.DisableExplorer); err != nil {
+		ui.Fatal("%v", err)
+	}
+}
+
 var joinCmd = &cobra.Command{
-	Use:   "join",
+	Use:   "join <chain-id|alias>",
 	Short: "Join a bitcoinx network",
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
@@ -30,42 +178,329 @@ var joinCmd = &cobra.Command{
 			chainID = args[0]
 		)
 
+		requireCommands("docker")
+
+		jsonOutput := jsonResult(cmd)
+
+		if err := ensureWritableDir(networksDir); err != nil {
+			ui.Fatal("%v", err)
+		}
+
+		chainID, err = resolveAlias(chainID)
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+		alias := ""
+		if chainID != args[0] {
+			alias = args[0]
+		}
+		if err := config.ValidateChainID(chainID); err != nil {
+			ui.Fatal("%v", err)
+		}
+
 		ui.Info("Joining network %s", ui.Emphasize(chainID))
 		cfg := &config.Config{
-			RootDir:        path.Join(networksDir, filepath.Base(chainID)),
-			Projectname:    " bitcoinx "
-			PublishNetwork: false,
-			ChainID:        chainID,
+			RootDir:         path.Join(networksDir, filepath.Base(chainID)),
+			PublishNetwork:  false,
+			ChainID:         chainID,
+			Ports:           defaults.Ports,
+			BootstrapPeers:  defaults.BootstrapPeers,
+			PersistentPeers: defaults.PersistentPeers,
+			ExplorerImage:   explorerImage(cmd, defaults.ExplorerImage),
+			DisableExplorer: defaults.DisableExplorer,
+			MemoryLimit:     defaults.MemoryLimit,
+			CPULimit:        defaults.CPULimit,
+			DisableLogFile:  defaults.DisableLogFile,
+			RPCBindAddress:  defaults.RPCBindAddress,
+			RPCHost:         defaults.RPCHost,
 		}
-		cfg.Ports, err = config.AllocatePorts()
+
+		detach, err := cmd.Flags().GetBool("detach")
 		if err != nil {
-			ui.Fatal("%v", err)
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		if detach {
+			runDetached(cfg, jsonOutput)
+			return
+		}
+		// The explorer port is only allocated if the explorer is enabled.
+		if cmd.Flags().Changed("no-explorer") {
+			cfg.DisableExplorer, err = cmd.Flags().GetBool("no-explorer")
+			if err != nil {
+				ui.Fatal("unable to resolve flag: %v", err)
+			}
+		}
+		if cfg.Ports == nil {
+			allocatePorts(cmd, cfg)
+		} else {
+			validatePorts(cfg)
+		}
+
+		// Command line flags override the configuration file.
+		if cmd.Flags().Changed("bootstrap") {
+			cfg.BootstrapPeers, err = cmd.Flags().GetStringSlice("bootstrap")
+			if err != nil {
+				ui.Fatal("unable to resolve flag: %v", err)
+			}
+		}
+
+		if cmd.Flags().Changed("memory") {
+			cfg.MemoryLimit, err = cmd.Flags().GetString("memory")
+			if err != nil {
+				ui.Fatal("unable to resolve flag: %v", err)
+			}
+		}
+		if cmd.Flags().Changed("cpus") {
+			cfg.CPULimit, err = cmd.Flags().GetString("cpus")
+			if err != nil {
+				ui.Fatal("unable to resolve flag: %v", err)
+			}
+		}
+		if cmd.Flags().Changed("rpc-bind") {
+			cfg.RPCBindAddress, err = cmd.Flags().GetString("rpc-bind")
+			if err != nil {
+				ui.Fatal("unable to resolve flag: %v", err)
+			}
+		}
+		if cmd.Flags().Changed("rpc-host") {
+			cfg.RPCHost, err = cmd.Flags().GetString("rpc-host")
+			if err != nil {
+				ui.Fatal("unable to resolve flag: %v", err)
+			}
+		}
+		if cmd.Flags().Changed("no-log-file") {
+			cfg.DisableLogFile, err = cmd.Flags().GetBool("no-log-file")
+			if err != nil {
+				ui.Fatal("unable to resolve flag: %v", err)
+			}
+		}
+		if err := cfg.Validate(); err != nil {
+			ui.Fatal("Invalid configuration: %v", err)
+		}
+
+		enableMDNS, err := cmd.Flags().GetBool("mdns")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		timeout, err := cmd.Flags().GetDuration("discovery-timeout")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		maxPeers, err := cmd.Flags().GetInt("max-peers")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		observe, err := cmd.Flags().GetBool("observe")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		genesisHash, err := cmd.Flags().GetString("genesis-hash")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		gatewayPort, err := cmd.Flags().GetInt("gateway-port")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
 		}
 
-		d := discovery.New(cfg.IPFSDir(), cfg.Ports.IPFS)
-		if err := d.Start(ctx); err != nil {
+		joinTimeout, err := cmd.Flags().GetDuration("timeout")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		requirePeers, err := cmd.Flags().GetBool("require-peers")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		forceRefresh, err := cmd.Flags().GetBool("force-refresh")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		restartPolicy := node.RestartPolicy{}
+		restartPolicy.MaxRestarts, err = cmd.Flags().GetInt("max-restarts")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		restartPolicy.Delay, err = cmd.Flags().GetDuration("restart-delay")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		// joinCtx bounds joining the network, up to finding its nodes. ctx
+		// keeps running the discovery server and the node afterwards.
+		var (
+			joinCtx    context.Context
+			joinCancel context.CancelFunc
+		)
+		if joinTimeout > 0 {
+			joinCtx, joinCancel = context.WithTimeout(ctx, joinTimeout)
+		} else {
+			joinCtx, joinCancel = context.WithCancel(ctx)
+		}
+		defer joinCancel()
+		// fatalJoin aborts the join with a clear message if it ran out of
+		// time, or with the given message otherwise.
+		fatalJoin := func(format string, args ...interface{}) {
+			if joinCtx.Err() == context.DeadlineExceeded {
+				ui.Fatal("Timed out joining network %s after %s", cfg.ChainID, joinTimeout)
+			}
+			ui.Fatal(format, args...)
+		}
+
+		// Local network files let the node rejoin without reaching the
+		// network, so look for them before starting discovery.
+		var network *discovery.NetworkInfo
+		if !forceRefresh {
+			network = localNetwork(cfg)
+		}
+		reused := network != nil
+
+		cfg.Ports.Release()
+		d, err := discovery.New(cfg.IPFSDir(), cfg.Ports.IPFS, discovery.Options{
+			BootstrapPeers: cfg.BootstrapPeers,
+			AliasesFile:    aliasesFile(),
+			IndexFile:      indexFile(),
+			EnableMDNS:     enableMDNS,
+			Timeout:        timeout,
+			MaxProviders:   maxPeers,
+			Observe:        observe,
+			GatewayPort:    gatewayPort,
+		})
+		if err != nil {
 			ui.Fatal("Failed to initialize discovery: %v", err)
 		}
+		peers, err := cmd.Flags().GetStringSlice("peer")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		// The discovery server outlives the join: start it with ctx, but
+		// stop waiting for it once the join times out.
+		startCh := make(chan error, 1)
+		go func() {
+			startCh <- d.Start(ctx)
+		}()
+		select {
+		case err = <-startCh:
+		case <-joinCtx.Done():
+			err = joinCtx.Err()
+		}
+		if err != nil {
+			if err == discovery.ErrNotConnected {
+				reportBootstrap(joinCtx, d)
+			}
+			// Explicit peers or local network files make up for
+			// unreachable bootstrap peers.
+			switch {
+			case err != discovery.ErrNotConnected:
+				fatalJoin("Failed to initialize discovery: %v", err)
+			case len(peers) > 0:
+				ui.Warn("Unable to connect to the bootstrap peers, relying on --peer")
+			case reused:
+				ui.Warn("Unable to connect to the bootstrap peers, starting from the local network files")
+			default:
+				fatalJoin("Failed to initialize discovery: %v", err)
+			}
+		} else {
+			go reportBootstrap(ctx, d)
+		}
 		defer d.Stop()
+		if addr := d.GatewayAddress(); addr != "" {
+			ui.Info("Network content available at %s", ui.Emphasize(addr+"/ipfs/"))
+		}
+		for _, peer := range peers {
+			if err := d.AddPeer(joinCtx, peer); err != nil {
+				fatalJoin("%v", err)
+			}
+		}
 
-		ui.Info("Retrieving network information...")
-		network, err := d.Join(ctx, cfg.ChainID)
-		if err != nil {
-			ui.Fatal("Unable to retrieve network information for %q: %v", cfg.ChainID, err)
+		if reused {
+			ui.Info("Reusing the network files in %s", ui.Emphasize(cfg.RootDir))
+		} else {
+			ui.Info("Retrieving network information...")
+			network, err = d.Join(joinCtx, cfg.ChainID)
+			if err != nil {
+				fatalJoin("Unable to retrieve network information for %q: %v", cfg.ChainID, err)
+			}
 		}
-		if err := network.WriteManifest(cfg.ManifestPath()); err != nil {
-			ui.Fatal("%v", err)
+		defer network.Close()
+		ui.Info("Joining network %s (%s)", ui.Emphasize(network.Name()), network.ChainID)
+		cfg.ChainID = network.ChainID
+		ui.Verbose("Genesis hash: %s", network.GenesisHash())
+		if genesisHash != "" && !strings.EqualFold(genesisHash, network.GenesisHash()) {
+			ui.Fatal("Genesis hash mismatch: expected %s, got %s", genesisHash, network.GenesisHash())
 		}
 		p, err := network.Project()
 		if err != nil {
 			ui.Fatal("%v", err)
 		}
+		if !reused {
+			if err := network.WriteManifest(cfg.ManifestPath()); err != nil {
+				ui.Fatal("%v", err)
+			}
+			if err := network.WriteImage(cfg.ImagePath()); err != nil {
+				fatalJoin("%v", err)
+			}
+		}
+		if !reused || !node.ImageExists(ctx, p.Image+":latest") {
+			if err := loadImage(ctx, cfg.ImagePath()); err != nil {
+				ui.Fatal("%v", err)
+			}
+		}
+		if !reused {
+			if err := saveNetwork(cfg, network); err != nil {
+				ui.Warn("Unable to record the network, joining again will download it: %v", err)
+			}
+			// The network files were all read: keep them while the
+			// node provides them.
+			if err := d.Pin(joinCtx, network.ChainID); err != nil {
+				ui.Warn("Unable to keep the network content, prune may remove it: %v", err)
+			}
+		}
+
+		// Look for the nodes of the network so that ours connects to them
+		// right away.
+		ui.Info("Looking for network nodes...")
+		found := 0
+		peerCh, err := d.Peers(joinCtx, cfg.ChainID)
+		if err != nil {
+			ui.Warn("Unable to look for network nodes: %v", err)
+		} else {
+			// The channel gets closed once the lookup completes, possibly
+			// without any node.
+			for peer := range peerCh {
+				if peer == nil {
+					continue
+				}
+				found++
+				cfg.PersistentPeers = append(cfg.PersistentPeers, node.PeerAddrs(peer)...)
+			}
+		}
+		if found == 0 {
+			// Running out of time only fails the join if no node
+			// was found.
+			if joinCtx.Err() == context.DeadlineExceeded {
+				fatalJoin("")
+			}
+			if requirePeers {
+				ui.Fatal("No nodes found for network %s", cfg.ChainID)
+			}
+			ui.Warn("No nodes found yet, the node will keep looking for them once started")
+		}
+		joinCancel()
 
 		n := node.New(cfg, d)
+		if jsonOutput {
+			n.NotifyReady(func(status *node.Status) {
+				printResult(newNetworkResult(status, alias))
+			})
+		}
 		errCh := make(chan error)
 		go func() {
 			defer close(errCh)
-			errCh <- n.Start(ctx, p, network.Genesis, false)
+			errCh <- n.StartSupervised(ctx, p, network.Genesis, restartPolicy)
 		}()
 
 		// Wait for the application to error out or the user to quit.
@@ -88,5 +523,101 @@ var joinCmd = &cobra.Command{
 }
 
 func init() {
+	joinCmd.Flags().StringSlice("bootstrap", nil, "IPFS bootstrap peers to use instead of the defaults")
+	joinCmd.Flags().StringSlice("peer", nil, "multiaddr of a node of the network to connect to directly")
+	joinCmd.Flags().Bool("observe", false, "join without announcing this node to the network")
+	joinCmd.Flags().Int("gateway-port", 0, "serve the network content over HTTP on this localhost port (disabled if 0)")
+	joinCmd.Flags().String("genesis-hash", "", "expected SHA-256 of the network genesis file, joining fails on mismatch")
+	joinCmd.Flags().Bool("mdns", false, "discover peers on the local network")
+	joinCmd.Flags().Duration("discovery-timeout", 10*time.Second, "timeout of a single peer lookup or announcement")
+	joinCmd.Flags().Bool("force-refresh", false, "download the network files again even if they were already fetched")
+	joinCmd.Flags().Bool("require-peers", false, "fail if no node of the network can be found")
+	joinCmd.Flags().Duration("timeout", 0, "maximum duration of the whole join, from connecting to finding the network nodes (no limit if 0)")
+	joinCmd.Flags().Int("max-peers", 10, "maximum number of nodes returned by a single peer lookup; higher values make lookups slower")
+	joinCmd.Flags().String("explorer-image", config.ExplorerImageDefault, "container image of the explorer")
+	joinCmd.Flags().Bool("no-explorer", false, "do not start the explorer")
+	joinCmd.Flags().StringP("output", "o", "text", "format of the command result: text or json")
+	joinCmd.Flags().Bool("detach", false, "run the node in the background")
+	joinCmd.Flags().Bool("no-log-file", false, "print the node output rather than saving it to the log file")
+	joinCmd.Flags().String("memory", "", "memory limit of the node and explorer containers (e.g. 512m)")
+	joinCmd.Flags().String("cpus", "", "number of CPUs the node and explorer containers may use (e.g. 1.5)")
+	joinCmd.Flags().Int("max-restarts", 0, "number of times the node gets restarted after a failure")
+	joinCmd.Flags().Duration("restart-delay", time.Second, "delay before restarting a failed node, doubled after every restart")
+	joinCmd.Flags().Int("base-port", config.BasePortDefault, "first port to try when allocating the node ports")
+	joinCmd.Flags().String("rpc-bind", "", "IP address to publish the application RPC on (defaults to all interfaces)")
+	joinCmd.Flags().String("rpc-host", "", "host advertised to reach the application RPC (defaults to localhost)")
+
 	rootCmd.AddCommand(joinCmd)
 }
+
+// loadImage loads the image tarball at path into docker.
+func loadImage(ctx context.Context, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := util.DockerLoad(ctx, f); err != nil {
+		return errors.Wrap(err, "unable to load image")
+	}
+	return nil
+}
+
+// joinedNetwork records the files of a joined network, so that joining it
+// again can reuse them rather than downloading them.
+type joinedNetwork struct {
+	ChainID      string `json:"chain_id"`
+	ManifestHash string `json:"manifest_hash"`
+	GenesisHash  string `json:"genesis_hash"`
+}
+
+// saveNetwork records the files of network once written in cfg.RootDir.
+func saveNetwork(cfg *config.Config, network *discovery.NetworkInfo) error {
+	data, err := json.MarshalIndent(&joinedNetwork{
+		ChainID:      network.ChainID,
+		ManifestHash: sha256Hex(network.Manifest),
+		GenesisHash:  network.GenesisHash(),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(cfg.NetworkFile(), data, 0644)
+}
+
+// localNetwork returns the network previously joined in cfg.RootDir, if its
+// manifest and genesis files are still the ones which were downloaded.
+// Returns nil otherwise.
+func localNetwork(cfg *config.Config) *discovery.NetworkInfo {
+	data, err := ioutil.ReadFile(cfg.NetworkFile())
+	if err != nil {
+		return nil
+	}
+	joined := joinedNetwork{}
+	if err := json.Unmarshal(data, &joined); err != nil || joined.ChainID != cfg.ChainID {
+		return nil
+	}
+
+	manifest, err := ioutil.ReadFile(cfg.ManifestPath())
+	if err != nil || sha256Hex(manifest) != joined.ManifestHash {
+		return nil
+	}
+	// The genesis is written by the node on its first start.
+	genesis, err := ioutil.ReadFile(cfg.GenesisPath())
+	if err != nil || sha256Hex(genesis) != joined.GenesisHash {
+		return nil
+	}
+	if _, err := os.Stat(cfg.ImagePath()); err != nil {
+		return nil
+	}
+
+	return &discovery.NetworkInfo{
+		ChainID:  joined.ChainID,
+		Manifest: manifest,
+		Genesis:  genesis,
+	}
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
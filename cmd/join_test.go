@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blocklayerhq/bitcoinx/config"
+	"github.com/blocklayerhq/bitcoinx/discovery"
+	"github.com/spf13/cobra"
+)
+
+// writeLocalNetwork writes the files of a joined network in a temporary
+// root directory.
+func writeLocalNetwork(t *testing.T) *config.Config {
+	root, err := ioutil.TempDir("", "join-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(root) })
+
+	cfg := &config.Config{RootDir: root, ChainID: "chain"}
+	if err := cfg.EnsureDirs(); err != nil {
+		t.Fatal(err)
+	}
+	network := &discovery.NetworkInfo{
+		ChainID:  "chain",
+		Manifest: []byte("name: test\n"),
+		Genesis:  []byte(`{"chain_id":"test"}`),
+	}
+	files := map[string][]byte{
+		cfg.ManifestPath(): network.Manifest,
+		cfg.GenesisPath():  network.Genesis,
+		cfg.ImagePath():    []byte("image"),
+	}
+	for p, data := range files {
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := saveNetwork(cfg, network); err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+func TestLocalNetwork(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(t *testing.T, cfg *config.Config)
+		reused bool
+	}{
+		{
+			name:   "unchanged",
+			change: func(t *testing.T, cfg *config.Config) {},
+			reused: true,
+		},
+		{
+			name: "other chain",
+			change: func(t *testing.T, cfg *config.Config) {
+				cfg.ChainID = "other"
+			},
+		},
+		{
+			name: "modified manifest",
+			change: func(t *testing.T, cfg *config.Config) {
+				if err := ioutil.WriteFile(cfg.ManifestPath(), []byte("name: modified\n"), 0644); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+		{
+			name: "modified genesis",
+			change: func(t *testing.T, cfg *config.Config) {
+				if err := ioutil.WriteFile(cfg.GenesisPath(), []byte("{}"), 0644); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+		{
+			name: "missing image",
+			change: func(t *testing.T, cfg *config.Config) {
+				if err := os.Remove(cfg.ImagePath()); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := writeLocalNetwork(t)
+			tt.change(t, cfg)
+			network := localNetwork(cfg)
+			if reused := network != nil; reused != tt.reused {
+				t.Fatalf("localNetwork reused = %v, want %v", reused, tt.reused)
+			}
+			if network != nil && network.ChainID != cfg.ChainID {
+				t.Errorf("chain ID = %q, want %q", network.ChainID, cfg.ChainID)
+			}
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "join-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	configPath := filepath.Join(dir, "config.yaml")
+	if err := ioutil.WriteFile(configPath, []byte("networks_dir: /from/config\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	savedDir, savedDefaults := networksDir, defaults
+	t.Cleanup(func() { networksDir, defaults = savedDir, savedDefaults })
+
+	tests := []struct {
+		name string
+		flag string
+		env  string
+		want string
+	}{
+		{name: "config", want: "/from/config"},
+		{name: "env", env: "/from/env", want: "/from/env"},
+		{name: "flag", flag: "/from/flag", env: "/from/env", want: "/from/flag"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("BITCOINX_NETWORKS_DIR", tt.env)
+			cmd := &cobra.Command{}
+			cmd.Flags().String("config", configPath, "")
+			cmd.Flags().String("networks-dir", tt.flag, "")
+			if err := loadConfig(cmd); err != nil {
+				t.Fatal(err)
+			}
+			if networksDir != tt.want {
+				t.Errorf("networks dir = %q, want %q", networksDir, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/blocklayerhq/bitcoinx/config"
+	"github.com/blocklayerhq/bitcoinx/discovery"
+	"github.com/blocklayerhq/bitcoinx/node"
+	"github.com/blocklayerhq/bitcoinx/project"
+	"github.com/blocklayerhq/bitcoinx/ui"
+	"github.com/spf13/cobra"
+)
+
+var leaveCmd = &cobra.Command{
+	Use:   "leave <chain-id>",
+	Short: "Leave a bitcoinx network",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var (
+			ctx     = context.Background()
+			chainID = args[0]
+		)
+
+		purge, err := cmd.Flags().GetBool("purge")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		cfg := &config.Config{
+			RootDir: path.Join(networksDir, filepath.Base(chainID)),
+			ChainID: chainID,
+		}
+		if _, err := os.Stat(cfg.RootDir); os.IsNotExist(err) {
+			ui.Fatal("Network %s has not been joined", ui.Emphasize(chainID))
+		}
+
+		ui.Info("Leaving network %s", ui.Emphasize(chainID))
+
+		if name := projectName(cfg); name != "" {
+			ui.Info("Stopping containers...")
+			if err := node.StopContainers(ctx, cfg, name); err != nil {
+				ui.Error("Failed to stop the node: %v", err)
+			}
+		}
+
+		// Give the node some time to shut down and release its state.
+		if !waitStopped(cfg, 10*time.Second) {
+			ui.Fatal("The node is still running, stop it before leaving the network")
+		}
+
+		if purge {
+			ui.Info("Removing %s", ui.Emphasize(cfg.RootDir))
+			if err := os.RemoveAll(cfg.RootDir); err != nil {
+				ui.Fatal("Failed to remove network data: %v", err)
+			}
+		} else {
+			ui.Info("Releasing network content...")
+			if err := unpinNetwork(ctx, cfg); err != nil {
+				ui.Error("Failed to release network content: %v", err)
+			}
+		}
+
+		ui.Success("Left network %s", ui.Emphasize(chainID))
+	},
+}
+
+func init() {
+	leaveCmd.Flags().Bool("purge", false, "also delete the chain data")
+
+	rootCmd.AddCommand(leaveCmd)
+}
+
+// projectName returns the name of the project running the network, or an
+// empty string if it can't be determined.
+func projectName(cfg *config.Config) string {
+	if status, err := node.ReadStatus(cfg); err == nil {
+		return status.Project
+	}
+	f, err := os.Open(cfg.ManifestPath())
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	p, err := project.Parse(f)
+	if err != nil {
+		return ""
+	}
+	return p.Name
+}
+
+// waitStopped waits for the node to remove its status file.
+func waitStopped(cfg *config.Config, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		if _, err := node.ReadStatus(cfg); os.IsNotExist(err) {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
+// unpinNetwork releases the network content from the local IPFS repository.
+func unpinNetwork(ctx context.Context, cfg *config.Config) error {
+	ports, err := config.AllocatePorts()
+	if err != nil {
+		return err
+	}
+	ports.Release()
+	d, err := discovery.New(cfg.IPFSDir(), ports.IPFS, discovery.Options{
+		AliasesFile: aliasesFile(),
+		IndexFile:   indexFile(),
+	})
+	if err != nil {
+		return err
+	}
+	if err := d.Start(ctx); err != nil {
+		return err
+	}
+	defer d.Stop()
+
+	return d.Unpin(ctx, cfg.ChainID)
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"text/tabwriter"
+	"time"
+
+	"github.com/blocklayerhq/bitcoinx/config"
+	"github.com/blocklayerhq/bitcoinx/discovery"
+	"github.com/blocklayerhq/bitcoinx/ui"
+	"github.com/spf13/cobra"
+)
+
+// networkListEntry is a network as printed by the list command.
+type networkListEntry struct {
+	discovery.NetworkSummary
+	Live bool `json:"live"`
+}
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the networks joined or published on this machine",
+	Args:  cobra.ExactArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		jsonOutput, err := cmd.Flags().GetBool("json")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		ports, err := config.AllocatePorts()
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+		ports.Release()
+		d, err := discovery.New(path.Join(networksDir, "ipfs"), ports.IPFS, discovery.Options{
+			AliasesFile: aliasesFile(),
+			IndexFile:   indexFile(),
+		})
+		if err != nil {
+			ui.Fatal("Failed to initialize discovery: %v", err)
+		}
+
+		networks, err := d.KnownNetworks()
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+
+		entries := make([]networkListEntry, 0, len(networks))
+		for _, n := range networks {
+			entries = append(entries, networkListEntry{NetworkSummary: n})
+		}
+
+		if len(entries) > 0 {
+			if err := d.Start(ctx); err != nil {
+				ui.Fatal("Failed to initialize discovery: %v", err)
+			}
+			defer d.Stop()
+
+			for i := range entries {
+				live, err := d.HasProviders(ctx, entries[i].ChainID)
+				if err != nil {
+					ui.Error("Unable to check network %s: %v", entries[i].ChainID, err)
+				}
+				entries[i].Live = live
+			}
+		}
+
+		if jsonOutput {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(entries); err != nil {
+				ui.Fatal("%v", err)
+			}
+			return
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "CHAIN ID\tALIAS\tLAST JOINED\tSTATUS")
+		for _, e := range entries {
+			status := "stale"
+			if e.Live {
+				status = "live"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", e.ChainID, e.Alias, e.LastJoined.Format(time.RFC822), status)
+		}
+		w.Flush()
+	},
+}
+
+func init() {
+	listCmd.Flags().Bool("json", false, "print the networks as JSON")
+
+	rootCmd.AddCommand(listCmd)
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/blocklayerhq/bitcoinx/config"
+	"github.com/blocklayerhq/bitcoinx/ui"
+	"github.com/spf13/cobra"
+)
+
+// logsPollInterval is how often the log file is checked for new lines when following.
+const logsPollInterval = 500 * time.Millisecond
+
+var logsCmd = &cobra.Command{
+	Use:   "logs <chain-id|alias>",
+	Short: "Print the logs of a joined network node",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		follow, err := cmd.Flags().GetBool("follow")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		tail, err := cmd.Flags().GetInt("tail")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		chainID, err := resolveAlias(args[0])
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+		cfg := &config.Config{
+			RootDir: path.Join(networksDir, filepath.Base(chainID)),
+		}
+
+		f, err := os.Open(cfg.LogFile())
+		if os.IsNotExist(err) {
+			ui.Fatal("Network %s: node has not produced logs", ui.Emphasize(chainID))
+		}
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+
+		if err := printTail(f, tail); err != nil {
+			ui.Fatal("Unable to read logs: %v", err)
+		}
+		if !follow {
+			f.Close()
+			return
+		}
+		if err := followLog(f, cfg.LogFile()); err != nil {
+			ui.Fatal("Unable to read logs: %v", err)
+		}
+	},
+}
+
+func init() {
+	logsCmd.Flags().BoolP("follow", "f", false, "keep printing new lines as they are logged")
+	logsCmd.Flags().Int("tail", -1, "number of lines to show from the end of the logs (all if negative)")
+
+	rootCmd.AddCommand(logsCmd)
+}
+
+// printTail prints the last n lines of r, or all of them if n is negative.
+func printTail(r io.Reader, n int) error {
+	if n < 0 {
+		_, err := io.Copy(os.Stdout, r)
+		return err
+	}
+
+	lines := make([][]byte, 0, n)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		if n == 0 {
+			continue
+		}
+		if len(lines) == n {
+			lines = lines[1:]
+		}
+		lines = append(lines, append([]byte(nil), scanner.Bytes()...))
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if len(lines) > 0 {
+		_, err := os.Stdout.Write(append(bytes.Join(lines, []byte("\n")), '\n'))
+		return err
+	}
+	return nil
+}
+
+// followLog prints data appended to f, reopening the file at p when it
+// gets rotated. The file being followed is closed on return.
+func followLog(f *os.File, p string) error {
+	defer func() { f.Close() }()
+
+	for {
+		if _, err := io.Copy(os.Stdout, f); err != nil {
+			return err
+		}
+		time.Sleep(logsPollInterval)
+
+		// Check whether the file was rotated.
+		current, err := os.Stat(p)
+		if err != nil {
+			continue
+		}
+		opened, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		if os.SameFile(current, opened) {
+			continue
+		}
+		// Flush whatever was written before the rotation.
+		if _, err := io.Copy(os.Stdout, f); err != nil {
+			return err
+		}
+		rotated, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		f.Close()
+		f = rotated
+	}
+}
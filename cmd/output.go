@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/blocklayerhq/bitcoinx/node"
+	"github.com/blocklayerhq/bitcoinx/ui"
+	"github.com/spf13/cobra"
+)
+
+// networkResult is the machine-readable result of the commands creating or
+// joining a network.
+type networkResult struct {
+	ChainID      string `json:"chain_id"`
+	Alias        string `json:"alias,omitempty"`
+	NodeID       string `json:"node_id,omitempty"`
+	PeerID       string `json:"peer_id,omitempty"`
+	RPCPort      int    `json:"rpc_port,omitempty"`
+	ExplorerPort int    `json:"explorer_port,omitempty"`
+}
+
+// newNetworkResult returns the result of a running node.
+func newNetworkResult(status *node.Status, alias string) *networkResult {
+	r := &networkResult{
+		ChainID: status.ChainID,
+		Alias:   alias,
+		NodeID:  status.NodeID,
+		PeerID:  status.DiscoveryID,
+	}
+	if status.Ports != nil {
+		r.RPCPort = status.Ports.TendermintRPC
+		if status.Explorer {
+			r.ExplorerPort = status.Ports.Explorer
+		}
+	}
+	return r
+}
+
+// jsonResult resolves the --output flag. When results are printed as JSON,
+// the regular output is moved to stderr so stdout only holds the result.
+func jsonResult(cmd *cobra.Command) bool {
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		ui.Fatal("unable to resolve flag: %v", err)
+	}
+	switch output {
+	case "text":
+		return false
+	case "json":
+		ui.SetOutput(os.Stderr)
+		return true
+	default:
+		ui.Fatal("invalid output format %q, expected text or json", output)
+		return false
+	}
+}
+
+// printResult prints the result of a command as JSON on stdout.
+func printResult(v interface{}) {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		ui.Fatal("%v", err)
+	}
+}
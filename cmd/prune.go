@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/blocklayerhq/bitcoinx/config"
+	"github.com/blocklayerhq/bitcoinx/discovery"
+	"github.com/blocklayerhq/bitcoinx/ui"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+var pruneCmd = &cobra.Command{
+	Use:   "prune [chain-id|alias]",
+	Short: "Reclaim the disk space used by unpinned IPFS content",
+	Long: `Garbage collect the IPFS repository of a joined network, removing the
+content which isn't pinned. The network content itself stays pinned and is
+kept. With --all, the repositories of every network get pruned.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		all, err := cmd.Flags().GetBool("all")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		var repos []string
+		switch {
+		case all && len(args) > 0:
+			ui.Fatal("--all cannot be combined with a network")
+		case all:
+			repos, err = filepath.Glob(path.Join(networksDir, "*", "state", "ipfs"))
+			if err != nil {
+				ui.Fatal("%v", err)
+			}
+			repos = append(repos, path.Join(networksDir, "ipfs"))
+		case len(args) == 1:
+			chainID, err := resolveAlias(args[0])
+			if err != nil {
+				ui.Fatal("%v", err)
+			}
+			cfg := &config.Config{
+				RootDir: path.Join(networksDir, filepath.Base(chainID)),
+			}
+			if _, err := os.Stat(cfg.RootDir); os.IsNotExist(err) {
+				ui.Fatal("Network %s has not been joined", ui.Emphasize(args[0]))
+			}
+			repos = []string{cfg.IPFSDir()}
+		default:
+			ui.Fatal("Specify a network to prune, or --all")
+		}
+
+		var (
+			total  uint64
+			failed bool
+		)
+		for _, repo := range repos {
+			if _, err := os.Stat(repo); os.IsNotExist(err) {
+				continue
+			}
+			ui.Info("Pruning %s", ui.Emphasize(repo))
+			res, err := pruneRepo(ctx, repo)
+			if err != nil {
+				ui.Error("Failed to prune %s: %v", repo, err)
+				failed = true
+				continue
+			}
+			ui.Success("Removed %d blocks, reclaimed %s", res.Blocks, humanize.Bytes(res.Reclaimed))
+			total += res.Reclaimed
+		}
+
+		if len(repos) > 1 {
+			ui.Info("Reclaimed %s in total", humanize.Bytes(total))
+		}
+		if failed {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	pruneCmd.Flags().Bool("all", false, "prune the repositories of every network")
+
+	rootCmd.AddCommand(pruneCmd)
+}
+
+// pruneRepo garbage collects the IPFS repository at dir. The repository is
+// opened offline, and can't be pruned while a node uses it.
+func pruneRepo(ctx context.Context, dir string) (*discovery.PruneResult, error) {
+	locked, err := discovery.RepoLocked(dir)
+	if err != nil {
+		return nil, err
+	}
+	if locked {
+		return nil, fmt.Errorf("in use by a running node, stop it first")
+	}
+
+	d, err := discovery.New(dir, 0, discovery.Options{
+		AliasesFile: aliasesFile(),
+		IndexFile:   indexFile(),
+		Offline:     true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Start(ctx); err != nil {
+		return nil, err
+	}
+	defer d.Stop()
+
+	return d.Prune(ctx)
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/blocklayerhq/bitcoinx/config"
+	"github.com/blocklayerhq/bitcoinx/discovery"
+	"github.com/blocklayerhq/bitcoinx/node"
+	"github.com/blocklayerhq/bitcoinx/project"
+	"github.com/blocklayerhq/bitcoinx/ui"
+	"github.com/spf13/cobra"
+)
+
+var publishCmd = &cobra.Command{
+	Use:   "publish",
+	Short: "Publish a new network without starting a node",
+	Long: `Publish a new network without starting a node.
+
+The network is made of the project manifest, its genesis file and its
+container image. The content is served to joining nodes whenever a node of
+this project is running.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		requireCommands("docker")
+
+		jsonOutput := jsonResult(cmd)
+
+		rootDir := getCwd(cmd)
+		p, err := project.Load(rootDir)
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+		cfg := &config.Config{
+			RootDir:        rootDir,
+			PublishNetwork: true,
+		}
+
+		alias, err := cmd.Flags().GetString("alias")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		genesisPath, err := cmd.Flags().GetString("genesis")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		if genesisPath == "" {
+			genesisPath = cfg.GenesisPath()
+		}
+		if _, err := os.Stat(genesisPath); err != nil {
+			ui.Fatal("Unable to find the genesis file (%v). Start the application once or use --genesis", err)
+		}
+		imagePath, err := cmd.Flags().GetString("image")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		bootstrapPeers, err := cmd.Flags().GetStringSlice("bootstrap")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		timeout, err := cmd.Flags().GetDuration("discovery-timeout")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		if imagePath == "" {
+			ui.Info("Saving image %s", ui.Emphasize(p.Image))
+			imagePath, err = node.SaveImage(ctx, p)
+			if err != nil {
+				ui.Fatal("%v", err)
+			}
+			defer os.Remove(imagePath)
+		}
+
+		ports, err := config.AllocatePorts()
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+		ports.Release()
+		d, err := discovery.New(cfg.IPFSDir(), ports.IPFS, discovery.Options{
+			BootstrapPeers: bootstrapPeers,
+			AliasesFile:    aliasesFile(),
+			IndexFile:      indexFile(),
+			Timeout:        timeout,
+		})
+		if err != nil {
+			ui.Fatal("Failed to initialize discovery: %v", err)
+		}
+		if err := d.Start(ctx); err != nil {
+			if err == discovery.ErrNotConnected {
+				reportBootstrap(ctx, d)
+			}
+			ui.Fatal("Failed to initialize discovery: %v", err)
+		}
+		defer d.Stop()
+		go reportBootstrap(ctx, d)
+
+		ui.Info("Publishing network...")
+		chainID, err := d.Publish(ctx, cfg.ManifestPath(), genesisPath, imagePath)
+		if err != nil {
+			ui.Fatal("Unable to publish network: %v", err)
+		}
+		if err := d.Provide(ctx, chainID); err != nil {
+			ui.Warn("Unable to announce network %s: %v", chainID, err)
+		}
+
+		ui.Success("Success! Published network %s as %s\n\nOther nodes can now join this network by running:\n  %s\n",
+			ui.Emphasize(p.Name),
+			ui.Emphasize(chainID),
+			ui.Emphasize(fmt.Sprintf("bitcoinx join %s", chainID)),
+		)
+
+		if alias != "" {
+			if err := d.PublishAlias(ctx, alias, chainID); err != nil {
+				ui.Fatal("Unable to publish alias: %v", err)
+			}
+			ui.Success("Network %s is also available locally as %s", ui.Emphasize(chainID), ui.Emphasize(alias))
+		}
+
+		if jsonOutput {
+			printResult(&networkResult{
+				ChainID: chainID,
+				Alias:   alias,
+				PeerID:  d.ID(),
+			})
+		}
+	},
+}
+
+func init() {
+	publishCmd.Flags().String("cwd", ".", "specifies the current working directory")
+	publishCmd.Flags().StringP("output", "o", "text", "format of the command result: text or json")
+	publishCmd.Flags().String("alias", "", "register a human-readable alias for the published network")
+	publishCmd.Flags().String("genesis", "", "genesis file of the network (defaults to the one of the application)")
+	publishCmd.Flags().String("image", "", "image tarball of the network (defaults to saving the application image)")
+	publishCmd.Flags().StringSlice("bootstrap", nil, "IPFS bootstrap peers to use instead of the defaults")
+	publishCmd.Flags().Duration("discovery-timeout", 10*time.Second, "timeout of a single peer lookup or announcement")
+
+	rootCmd.AddCommand(publishCmd)
+}
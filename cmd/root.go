@@ -25,11 +25,36 @@ var rootCmd = &cobra.Command{
 			// By default, enable colors only if stdout is a tty.
 			ui.EnableColors(terminal.IsTerminal(int(os.Stdout.Fd())))
 		}
+
+		quiet, err := cmd.Flags().GetBool("quiet")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		verbose, err := cmd.Flags().GetBool("verbose")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		switch {
+		case quiet && verbose:
+			ui.Fatal("both options --quiet and --verbose cannot be combined")
+		case quiet:
+			ui.SetLevel(ui.LevelQuiet)
+		case verbose:
+			ui.SetLevel(ui.LevelVerbose)
+		}
+
+		if err := loadConfig(cmd); err != nil {
+			ui.Fatal("%v", err)
+		}
 	},
 }
 
 func init() {
 	rootCmd.PersistentFlags().Bool("no-color", false, "disable output coloring")
+	rootCmd.PersistentFlags().BoolP("quiet", "q", false, "only print errors")
+	rootCmd.PersistentFlags().Bool("verbose", false, "enable verbose output")
+	rootCmd.PersistentFlags().String("config", configFile, "configuration file")
+	rootCmd.PersistentFlags().String("networks-dir", "", "directory holding the networks (defaults to $BITCOINX_NETWORKS_DIR, the networks_dir setting or ~/.bitcoinx/networks)")
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
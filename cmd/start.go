@@ -2,9 +2,11 @@ package cmd
 
 import (
 	"context"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/blocklayerhq/bitcoinx/config"
 	"github.com/blocklayerhq/bitcoinx/discovery"
@@ -19,6 +21,8 @@ var startCmd = &cobra.Command{
 	Short: "Start the bitcoinx application",
 	Args:  cobra.ExactArgs(0),
 	Run: func(cmd *cobra.Command, args []string) {
+		requireCommands("docker")
+
 		rootDir := getCwd(cmd)
 		p, err := project.Load(rootDir)
 		if err != nil {
@@ -35,30 +39,114 @@ var startCmd = &cobra.Command{
 			ui.Fatal("unable to parse --edit-genesis: %v", err)
 		}
 
+		alias, err := cmd.Flags().GetString("alias")
+		if err != nil {
+			ui.Fatal("unable to parse --alias flag: %v", err)
+		}
+
+		noExplorer, err := cmd.Flags().GetBool("no-explorer")
+		if err != nil {
+			ui.Fatal("unable to parse --no-explorer flag: %v", err)
+		}
+
+		noLogFile, err := cmd.Flags().GetBool("no-log-file")
+		if err != nil {
+			ui.Fatal("unable to parse --no-log-file flag: %v", err)
+		}
+
+		memoryLimit, err := cmd.Flags().GetString("memory")
+		if err != nil {
+			ui.Fatal("unable to parse --memory flag: %v", err)
+		}
+
+		cpuLimit, err := cmd.Flags().GetString("cpus")
+		if err != nil {
+			ui.Fatal("unable to parse --cpus flag: %v", err)
+		}
+
+		rpcBind, err := cmd.Flags().GetString("rpc-bind")
+		if err != nil {
+			ui.Fatal("unable to parse --rpc-bind flag: %v", err)
+		}
+
+		rpcHost, err := cmd.Flags().GetString("rpc-host")
+		if err != nil {
+			ui.Fatal("unable to parse --rpc-host flag: %v", err)
+		}
+
+		if rpcBind != "" && net.ParseIP(rpcBind) == nil {
+			ui.Fatal("invalid --rpc-bind %q: must be an IP address", rpcBind)
+		}
+
 		if editGenesis == true && chainID != "" {
 			ui.Fatal("both options --join and --edit-genesis cannot be combined")
 		}
 
 		ctx := context.Background()
 		cfg := &config.Config{
-			RootDir:        rootDir,
-			Projectname:    bitcoinx,
-			ChainID:        chainID,
-			PublishNetwork: true,
+			RootDir:         rootDir,
+			ChainID:         chainID,
+			PublishNetwork:  true,
+			Alias:           alias,
+			ExplorerImage:   explorerImage(cmd, ""),
+			DisableExplorer: noExplorer,
+			MemoryLimit:     memoryLimit,
+			CPULimit:        cpuLimit,
+			DisableLogFile:  noLogFile,
+			RPCBindAddress:  rpcBind,
+			RPCHost:         rpcHost,
 		}
 
-		cfg.Ports, err = config.AllocatePorts()
+		allocatePorts(cmd, cfg)
+
+		ui.Info("Starting %s", ui.Emphasize(p.Name))
+
+		bootstrapPeers, err := cmd.Flags().GetStringSlice("bootstrap")
 		if err != nil {
-			ui.Fatal("%v", err)
+			ui.Fatal("unable to resolve flag: %v", err)
 		}
 
-		ui.Info("Starting %s", ui.Emphasize(p.Name))
+		enableMDNS, err := cmd.Flags().GetBool("mdns")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
 
-		d := discovery.New(cfg.IPFSDir(), cfg.Ports.IPFS)
+		timeout, err := cmd.Flags().GetDuration("discovery-timeout")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		maxPeers, err := cmd.Flags().GetInt("max-peers")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		cfg.Ports.Release()
+		d, err := discovery.New(cfg.IPFSDir(), cfg.Ports.IPFS, discovery.Options{
+			BootstrapPeers: bootstrapPeers,
+			AliasesFile:    aliasesFile(),
+			IndexFile:      indexFile(),
+			EnableMDNS:     enableMDNS,
+			Timeout:        timeout,
+			MaxProviders:   maxPeers,
+		})
+		if err != nil {
+			ui.Fatal("Failed to initialize discovery: %v", err)
+		}
 		if err := d.Start(ctx); err != nil {
+			if err == discovery.ErrNotConnected {
+				reportBootstrap(ctx, d)
+			}
 			ui.Fatal("Failed to initialize discovery: %v", err)
 		}
 		defer d.Stop()
+		go reportBootstrap(ctx, d)
+		for _, addr := range d.ListenAddresses() {
+			ui.Verbose("Discovery listening on %s", addr)
+		}
+		for _, addr := range d.AnnounceAddresses() {
+			ui.Verbose("Discovery announcing %s", addr)
+		}
 
 		var network *discovery.NetworkInfo
 		if cfg.ChainID != "" {
@@ -70,6 +158,9 @@ var startCmd = &cobra.Command{
 			if err != nil {
 				ui.Fatal("Unable to retrieve network information for %q: %v", cfg.ChainID, err)
 			}
+			// The local project image is used, not the network one.
+			network.Close()
+			cfg.ChainID = network.ChainID
 		}
 
 		n := node.New(cfg, d)
@@ -106,6 +197,19 @@ var startCmd = &cobra.Command{
 func init() {
 	startCmd.Flags().String("cwd", ".", "specifies the current working directory")
 	startCmd.Flags().String("join", "", "join a network")
+	startCmd.Flags().StringSlice("bootstrap", nil, "IPFS bootstrap peers to use instead of the defaults")
+	startCmd.Flags().Bool("mdns", false, "discover peers on the local network")
+	startCmd.Flags().Duration("discovery-timeout", 10*time.Second, "timeout of a single peer lookup or announcement")
+	startCmd.Flags().Int("max-peers", 10, "maximum number of nodes returned by a single peer lookup; higher values make lookups slower")
+	startCmd.Flags().Int("base-port", config.BasePortDefault, "first port to try when allocating the node ports")
+	startCmd.Flags().String("explorer-image", config.ExplorerImageDefault, "container image of the explorer")
+	startCmd.Flags().Bool("no-explorer", false, "do not start the explorer")
+	startCmd.Flags().Bool("no-log-file", false, "print the node output rather than saving it to the log file")
+	startCmd.Flags().String("memory", "", "memory limit of the node and explorer containers (e.g. 512m)")
+	startCmd.Flags().String("cpus", "", "number of CPUs the node and explorer containers may use (e.g. 1.5)")
+	startCmd.Flags().String("rpc-bind", "", "IP address to publish the application RPC on (defaults to all interfaces)")
+	startCmd.Flags().String("rpc-host", "", "host advertised to reach the application RPC (defaults to localhost)")
+	startCmd.Flags().String("alias", "", "register a human-readable alias for the published network")
 	startCmd.Flags().Bool("edit-genesis", false, "spawns an editor to change the genesis file before the chain starts (only works if the chain hasn't been initialized)")
 
 	rootCmd.AddCommand(startCmd)
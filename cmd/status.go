@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/blocklayerhq/bitcoinx/config"
+	"github.com/blocklayerhq/bitcoinx/node"
+	"github.com/blocklayerhq/bitcoinx/ui"
+	"github.com/blocklayerhq/bitcoinx/util"
+	"github.com/spf13/cobra"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status [chain-id]",
+	Short: "Report the health of a running node",
+	Long:  "Report the health of the node running in the current project, or of the joined network with the given chain ID.",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		cfg := &config.Config{
+			RootDir: getCwd(cmd),
+		}
+		if len(args) == 1 {
+			cfg.RootDir = path.Join(networksDir, filepath.Base(args[0]))
+		}
+
+		status, err := node.ReadStatus(cfg)
+		if os.IsNotExist(err) {
+			ui.Fatal("No node is running in %s", ui.Emphasize(cfg.RootDir))
+		}
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+
+		ui.Info("Status of %s", ui.Emphasize(status.Project))
+		ui.Success("  Chain ID                  : %s", ui.Emphasize(status.ChainID))
+		ui.Success("  Node ID                   : %s", ui.Emphasize(status.NodeID))
+		ui.Success("  Discovery peer ID         : %s", ui.Emphasize(status.DiscoveryID))
+		ui.Success("  Connected peers           : %s", ui.Emphasize(fmt.Sprintf("%d", status.ConnectedPeers)))
+		for _, addr := range status.ListenAddresses {
+			ui.Success("  Listening on              : %s", ui.Emphasize(addr))
+		}
+		ui.Success("  Last updated              : %s", ui.Emphasize(status.UpdatedAt.Format(time.RFC1123)))
+
+		if err := checkRPC(ctx, rpcAddress(status)); err != nil {
+			ui.Error("  Application RPC           : %v", err)
+		} else {
+			ui.Success("  Application RPC           : %s", ui.Emphasize(rpcURL(status)))
+		}
+
+		switch {
+		case !status.Explorer:
+			ui.Success("  BitcoinX Explorer         : %s", ui.Emphasize("disabled"))
+		case explorerRunning(ctx, status.Project):
+			ui.Success("  BitcoinX Explorer         : %s", ui.Emphasize(fmt.Sprintf("http://localhost:%d/", status.Ports.Explorer)))
+		default:
+			ui.Error("  BitcoinX Explorer         : not running")
+		}
+	},
+}
+
+func init() {
+	statusCmd.Flags().String("cwd", ".", "specifies the current working directory")
+
+	rootCmd.AddCommand(statusCmd)
+}
+
+// rpcURL returns the advertised URL of the Tendermint RPC of a node.
+// Nodes started by older versions were only advertised on localhost.
+func rpcURL(status *node.Status) string {
+	if status.RPCURL != "" {
+		return status.RPCURL
+	}
+	return fmt.Sprintf("http://localhost:%d/", status.Ports.TendermintRPC)
+}
+
+// rpcAddress returns the address the Tendermint RPC of a node is reachable
+// at locally.
+func rpcAddress(status *node.Status) string {
+	if status.RPCAddress != "" {
+		return status.RPCAddress
+	}
+	return fmt.Sprintf("localhost:%d", status.Ports.TendermintRPC)
+}
+
+// checkRPC makes sure the Tendermint RPC at addr is responding.
+func checkRPC(ctx context.Context, addr string) error {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("http://%s/status", addr), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("not responding")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request failed with code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// explorerRunning returns true if the explorer container of the project is running.
+func explorerRunning(ctx context.Context, project string) bool {
+	out, err := util.Output(ctx, "docker",
+		"ps", "-q",
+		"-f", "label=bitcoinx.cosmos.explorer",
+		"-f", "label=bitcoinx.project="+project,
+	)
+	return err == nil && strings.TrimSpace(string(out)) != ""
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/blocklayerhq/bitcoinx/config"
+	"github.com/blocklayerhq/bitcoinx/node"
+	"github.com/blocklayerhq/bitcoinx/ui"
+	"github.com/spf13/cobra"
+)
+
+var stopCmd = &cobra.Command{
+	Use:   "stop <chain-id|alias>",
+	Short: "Stop the node running for a joined network",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		chainID, err := resolveAlias(args[0])
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+		cfg := &config.Config{
+			RootDir: path.Join(networksDir, filepath.Base(chainID)),
+			ChainID: chainID,
+		}
+
+		if stopDetached(cfg) {
+			ui.Verbose("Stopping the background node process")
+		}
+
+		name := projectName(cfg)
+		if name == "" {
+			ui.Info("No node running for network %s", ui.Emphasize(chainID))
+			return
+		}
+
+		ids, err := node.ListContainers(ctx, cfg, name)
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+		if len(ids) == 0 {
+			ui.Info("No node running for network %s", ui.Emphasize(chainID))
+		}
+		if err := node.StopContainers(ctx, cfg, name); err != nil {
+			ui.Fatal("Failed to stop the node: %v", err)
+		}
+		for _, id := range ids {
+			ui.Success("Stopped container %s", ui.Emphasize(id))
+		}
+
+		// The node removes its status file when it exits. Clean it up
+		// ourselves if it didn't get a chance to.
+		if !waitStopped(cfg, 10*time.Second) {
+			if err := os.Remove(cfg.StatusFile()); err != nil && !os.IsNotExist(err) {
+				ui.Error("Unable to remove status file: %v", err)
+			}
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(stopCmd)
+}
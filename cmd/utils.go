@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/blocklayerhq/chainkit/util"
 	"github.com/spf13/cobra"
 )
 
@@ -40,3 +41,10 @@ func goPath() string {
 func goSrc() string {
 	return path.Join(goPath(), "src")
 }
+
+// requireCommands aborts if any of the tools a command depends on is missing.
+func requireCommands(commands ...string) {
+	if err := util.RequireCommands(commands...); err != nil {
+		ui.Fatal("%v", err)
+	}
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/blocklayerhq/bitcoinx/ui"
+	"github.com/blocklayerhq/bitcoinx/version"
+	"github.com/spf13/cobra"
+)
+
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Print the version information",
+	Args:  cobra.ExactArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		jsonOutput, err := cmd.Flags().GetBool("json")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		info := map[string]string{
+			"version":    version.Version,
+			"commit":     version.Commit,
+			"build_date": version.BuildDate,
+			"go_version": runtime.Version(),
+			"platform":   runtime.GOOS + "/" + runtime.GOARCH,
+		}
+
+		if jsonOutput {
+			if err := json.NewEncoder(os.Stdout).Encode(info); err != nil {
+				ui.Fatal("%v", err)
+			}
+			return
+		}
+
+		fmt.Printf("Version:    %s\n", info["version"])
+		fmt.Printf("Commit:     %s\n", info["commit"])
+		fmt.Printf("Build date: %s\n", info["build_date"])
+		fmt.Printf("Go version: %s\n", info["go_version"])
+		fmt.Printf("Platform:   %s\n", info["platform"])
+	},
+}
+
+func init() {
+	versionCmd.Flags().Bool("json", false, "print the version information as JSON")
+
+	rootCmd.AddCommand(versionCmd)
+}
@@ -1,15 +1,159 @@
 package config
 
 import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
 	"path"
+	"strconv"
+	"strings"
+
+	cid "github.com/ipsn/go-ipfs/gxlibs/github.com/ipfs/go-cid"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
 )
 
+// ExplorerImageDefault is the container image used for running the BitcoinX
+// Explorer when none is configured.
+const ExplorerImageDefault = "samalba/bitcoinx-explorer-localdev:20181204"
+
 // Config represents the node configuration.
 type Config struct {
-	RootDir        string
-	Ports          *PortMapper
-	ChainID        string
-	PublishNetwork bool
+	RootDir        string      `yaml:"-"`
+	Ports          *PortMapper `yaml:"ports,omitempty"`
+	ChainID        string      `yaml:"-"`
+	PublishNetwork bool        `yaml:"-"`
+	// Alias is a human-readable name registered for the published network.
+	Alias string `yaml:"-"`
+
+	// NetworksDir is the directory holding the joined networks.
+	NetworksDir string `yaml:"networks_dir,omitempty"`
+	// BootstrapPeers are the IPFS bootstrap peers used for discovery.
+	BootstrapPeers []string `yaml:"bootstrap_peers,omitempty"`
+	// PersistentPeers are the Tendermint peers ("<node id>@<ip>:<port>")
+	// the node keeps connections to.
+	PersistentPeers []string `yaml:"persistent_peers,omitempty"`
+	// ExplorerImage is the container image of the explorer.
+	ExplorerImage string `yaml:"explorer_image,omitempty"`
+	// DisableExplorer prevents the explorer from being started.
+	DisableExplorer bool `yaml:"disable_explorer,omitempty"`
+
+	// DisableLogFile sends the node output to the terminal rather than to
+	// the log file.
+	DisableLogFile bool `yaml:"disable_log_file,omitempty"`
+
+	// MemoryLimit is the memory limit of each container (e.g. "512m").
+	// Unlimited if empty.
+	MemoryLimit string `yaml:"memory_limit,omitempty"`
+	// CPULimit is the number of CPUs each container may use (e.g. "1.5").
+	// Unlimited if empty.
+	CPULimit string `yaml:"cpu_limit,omitempty"`
+
+	// RPCBindAddress is the IP address the Tendermint RPC port is published
+	// on, e.g. 127.0.0.1 to only accept local clients. Defaults to all the
+	// interfaces.
+	RPCBindAddress string `yaml:"rpc_bind_address,omitempty"`
+	// RPCHost is the host advertised to reach the Tendermint RPC and the
+	// explorer, such as the external name of a remote machine. Defaults to
+	// localhost.
+	RPCHost string `yaml:"rpc_host,omitempty"`
+}
+
+// Load loads a configuration file. A missing file results in an empty
+// configuration.
+func Load(path string) (*Config, error) {
+	c := &Config{}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read config file")
+	}
+	if err := yaml.Unmarshal(data, c); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse config file %q", path)
+	}
+	return c, nil
+}
+
+// Save writes the configuration file to path.
+func (c *Config) Save(p string) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path.Dir(p), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(p, data, 0644); err != nil {
+		return errors.Wrap(err, "unable to write config file")
+	}
+	return nil
+}
+
+// ValidateChainID makes sure id is a network CID or an IPNS name.
+func ValidateChainID(id string) error {
+	if id == "" {
+		return errors.New("chain ID not set")
+	}
+	if strings.HasPrefix(id, "/ipns/") {
+		return nil
+	}
+	if _, err := cid.Decode(id); err != nil {
+		return errors.Wrapf(err, "invalid chain ID %q", id)
+	}
+	return nil
+}
+
+// Validate makes sure the configuration is complete and usable.
+// The state directories get created in the process.
+func (c *Config) Validate() error {
+	if c.RootDir == "" {
+		return errors.New("root directory not set")
+	}
+	if !c.PublishNetwork {
+		if err := ValidateChainID(c.ChainID); err != nil {
+			return err
+		}
+	}
+	if c.Ports == nil {
+		return errors.New("ports not allocated")
+	}
+	if c.RPCBindAddress != "" && net.ParseIP(c.RPCBindAddress) == nil {
+		return fmt.Errorf("invalid RPC bind address %q: must be an IP address", c.RPCBindAddress)
+	}
+
+	if err := c.EnsureDirs(); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(c.RootDir, ".write-test")
+	if err != nil {
+		return errors.Wrapf(err, "root directory %q is not writable", c.RootDir)
+	}
+	f.Close()
+	return os.Remove(f.Name())
+}
+
+// EnsureDirs creates the state directory layout.
+func (c *Config) EnsureDirs() error {
+	dirs := []struct {
+		path string
+		perm os.FileMode
+	}{
+		{c.StateDir(), 0755},
+		{c.DataDir(), 0755},
+		{c.ConfigDir(), 0755},
+		// The CLI and IPFS directories hold private keys.
+		{c.CLIDir(), 0700},
+		{c.IPFSDir(), 0700},
+	}
+	for _, dir := range dirs {
+		if err := os.MkdirAll(dir.path, dir.perm); err != nil {
+			return errors.Wrapf(err, "unable to create directory %q", dir.path)
+		}
+	}
+	return nil
 }
 
 // StateDir returns the state directory within the project.
@@ -22,6 +166,64 @@ func (c *Config) LogFile() string {
 	return path.Join(c.RootDir, "log")
 }
 
+// StatusFile returns the path of the file holding the running node status.
+func (c *Config) StatusFile() string {
+	return path.Join(c.RootDir, "status.json")
+}
+
+// PIDFile returns the path of the file holding the PID of a detached node.
+func (c *Config) PIDFile() string {
+	return path.Join(c.RootDir, "node.pid")
+}
+
+// DetachedLogFile returns the path of the file receiving the output of a
+// detached node.
+func (c *Config) DetachedLogFile() string {
+	return path.Join(c.RootDir, "detached.log")
+}
+
+// NetworkFile returns the path of the file recording the joined network.
+func (c *Config) NetworkFile() string {
+	return path.Join(c.StateDir(), "network.json")
+}
+
+// PortsFile returns the path of the file holding the ports of the node.
+func (c *Config) PortsFile() string {
+	return path.Join(c.StateDir(), "ports.yml")
+}
+
+// LoadPorts loads the ports previously saved with SavePorts. Returns nil if
+// no ports were saved.
+func (c *Config) LoadPorts() (*PortMapper, error) {
+	data, err := ioutil.ReadFile(c.PortsFile())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read ports file")
+	}
+	ports := &PortMapper{}
+	if err := yaml.Unmarshal(data, ports); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse ports file %q", c.PortsFile())
+	}
+	return ports, nil
+}
+
+// SavePorts persists the ports so the node keeps them across restarts.
+func (c *Config) SavePorts() error {
+	data, err := yaml.Marshal(c.Ports)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.StateDir(), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(c.PortsFile(), data, 0644); err != nil {
+		return errors.Wrap(err, "unable to write ports file")
+	}
+	return nil
+}
+
 // DataDir returns the data directory within the project state.
 func (c *Config) DataDir() string {
 	return path.Join(c.StateDir(), "data")
@@ -42,6 +244,12 @@ func (c *Config) ManifestPath() string {
 	return path.Join(c.RootDir, "chainkit.yml")
 }
 
+// ImagePath returns the path of the network container image tarball. The
+// image is stored decompressed, as streamed by the discovery.
+func (c *Config) ImagePath() string {
+	return path.Join(c.RootDir, "image.tar")
+}
+
 // GenesisPath returns the genesis path for the project.
 func (c *Config) GenesisPath() string {
 	return path.Join(c.ConfigDir(), "genesis.json")
@@ -52,6 +260,33 @@ func (c *Config) CLIDir() string {
 	return path.Join(c.StateDir(), "cli")
 }
 
+// RPCURL returns the advertised URL of the Tendermint RPC.
+func (c *Config) RPCURL() string {
+	return fmt.Sprintf("http://%s/", net.JoinHostPort(c.advertisedHost(), strconv.Itoa(c.Ports.TendermintRPC)))
+}
+
+// ExplorerURL returns the advertised URL of the explorer.
+func (c *Config) ExplorerURL() string {
+	return fmt.Sprintf("http://%s/?rpc_port=%d", net.JoinHostPort(c.advertisedHost(), strconv.Itoa(c.Ports.Explorer)), c.Ports.TendermintRPC)
+}
+
+// LocalRPCAddress returns the "host:port" address this machine reaches the
+// Tendermint RPC at.
+func (c *Config) LocalRPCAddress() string {
+	host := "localhost"
+	if ip := net.ParseIP(c.RPCBindAddress); ip != nil && !ip.IsUnspecified() {
+		host = c.RPCBindAddress
+	}
+	return net.JoinHostPort(host, strconv.Itoa(c.Ports.TendermintRPC))
+}
+
+func (c *Config) advertisedHost() string {
+	if c.RPCHost != "" {
+		return c.RPCHost
+	}
+	return "localhost"
+}
+
 // IPFSDir returns the IPFS data directory within the project state.
 func (c *Config) IPFSDir() string {
 	return path.Join(c.StateDir(), "ipfs")
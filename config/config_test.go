@@ -0,0 +1,50 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestEnsureDirs(t *testing.T) {
+	root, err := ioutil.TempDir("", "config-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	c := &Config{RootDir: root}
+	if err := c.EnsureDirs(); err != nil {
+		t.Fatal(err)
+	}
+
+	dirs := []struct {
+		path string
+		perm os.FileMode
+	}{
+		{c.StateDir(), 0755},
+		{c.DataDir(), 0755},
+		{c.ConfigDir(), 0755},
+		{c.CLIDir(), 0700},
+		{c.IPFSDir(), 0700},
+	}
+	for _, dir := range dirs {
+		fi, err := os.Stat(dir.path)
+		if err != nil {
+			t.Errorf("%s not created: %v", dir.path, err)
+			continue
+		}
+		if !fi.IsDir() {
+			t.Errorf("%s is not a directory", dir.path)
+		}
+		// The umask may only remove permissions.
+		if perm := fi.Mode().Perm(); perm&^dir.perm != 0 {
+			t.Errorf("%s has permissions %v, want at most %v", dir.path, perm, dir.perm)
+		}
+	}
+
+	// Existing directories are fine.
+	if err := c.EnsureDirs(); err != nil {
+		t.Fatal(err)
+	}
+}
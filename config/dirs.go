@@ -0,0 +1,34 @@
+package config
+
+import (
+	"os"
+	"path"
+	"runtime"
+)
+
+// appName is the name of the application directories.
+const appName = "bitcoinx"
+
+// DataHome returns the directory holding the application data, such as
+// joined networks.
+// On Linux, $XDG_DATA_HOME is honored when set.
+func DataHome() string {
+	return xdgDir("XDG_DATA_HOME")
+}
+
+// ConfigHome returns the directory holding the application configuration.
+// On Linux, $XDG_CONFIG_HOME is honored when set.
+func ConfigHome() string {
+	return xdgDir("XDG_CONFIG_HOME")
+}
+
+// xdgDir returns the application directory within the XDG base directory
+// set in env, falling back to $HOME/.bitcoinx.
+func xdgDir(env string) string {
+	if runtime.GOOS != "darwin" && runtime.GOOS != "windows" {
+		if dir := os.Getenv(env); dir != "" {
+			return path.Join(dir, appName)
+		}
+	}
+	return path.Join(os.Getenv("HOME"), "."+appName)
+}
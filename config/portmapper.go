@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strings"
 
 	"github.com/blocklayerhq/chainkit/ui"
 )
@@ -17,6 +18,11 @@ const (
 	numPorts = 5
 	// portStep is the step between port ranges
 	portStep = 11
+	// explorerOffset is the offset of the explorer port within a range.
+	explorerOffset = 0
+
+	// BasePortDefault is the first port tried when allocating ports.
+	BasePortDefault = minPort
 )
 
 var (
@@ -24,57 +30,156 @@ var (
 	ErrPortsUnavailable = errors.New("unable to allocate ports")
 )
 
-// PortMapper holds port configuration.
+// PortMapper holds port configuration. The explorer port is zero if it
+// wasn't allocated.
 type PortMapper struct {
-	Explorer      int
-	TendermintRPC int
-	TendermintP2P int
-	IPFS          int
+	Explorer      int `yaml:"explorer"`
+	TendermintRPC int `yaml:"tendermint_rpc"`
+	TendermintP2P int `yaml:"tendermint_p2p"`
+	IPFS          int `yaml:"ipfs"`
+
+	// listeners hold the allocated ports until Release is called.
+	listeners []net.Listener
 }
 
-// AllocatePorts will allocate a set of ports
+// AllocatePorts will allocate a set of ports, starting from the default base port.
 func AllocatePorts() (*PortMapper, error) {
-	for port := minPort; port < maxPort; port += portStep {
-		if !portRangeAvailable(port, numPorts) {
+	return AllocatePortsFrom(BasePortDefault, true)
+}
+
+// AllocatePortsFrom will allocate a set of ports, starting from base. The
+// explorer port is only allocated if explorer is true.
+//
+// The ports are reserved by listening on them so concurrent allocations
+// can't pick the same range. Release must be called before the ports are
+// actually used.
+func AllocatePortsFrom(base int, explorer bool) (*PortMapper, error) {
+	for port := base; port < maxPort; port += portStep {
+		listeners, ok := reservePortRange(port, numPorts, explorer)
+		if !ok {
 			continue
 		}
-		if port != minPort {
+		if port != base {
 			ui.Error("Port range %d-%d not available, using %d-%d instead",
-				minPort, minPort+numPorts,
+				base, base+numPorts,
 				port, port+numPorts)
 		}
-		return &PortMapper{
-			Explorer:      port + 0,
+		p := &PortMapper{
 			TendermintRPC: port + 1,
 			TendermintP2P: port + 2,
 			IPFS:          port + 3,
-		}, nil
+			listeners:     listeners,
+		}
+		if explorer {
+			p.Explorer = port + explorerOffset
+		}
+		return p, nil
 	}
 
 	return nil, ErrPortsUnavailable
 }
 
-func portRangeAvailable(base, n int) bool {
-	// We are dialing in addition to listening because for some reason,
-	// if the port is being used by a container, it will listen just fine
-	// rather than throwing an address already in use.
+// EnableExplorer sets the explorer port if it wasn't allocated, to the one
+// left for it in the range of the other ports.
+func (p *PortMapper) EnableExplorer() {
+	if p.Explorer == 0 {
+		p.Explorer = p.TendermintRPC - 1 + explorerOffset
+	}
+}
 
-	for i := 0; i < n; i++ {
-		port := base + i
+// String returns a human readable list of the ports.
+func (p *PortMapper) String() string {
+	return fmt.Sprintf("explorer=%d tendermint_rpc=%d tendermint_p2p=%d ipfs=%d",
+		p.Explorer, p.TendermintRPC, p.TendermintP2P, p.IPFS)
+}
 
-		// First, try to listen to that port.
-		l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
-		if err != nil {
-			return false
-		}
+// Release frees the ports reserved by AllocatePorts so they can be used.
+func (p *PortMapper) Release() {
+	if p == nil {
+		return
+	}
+	for _, l := range p.listeners {
 		l.Close()
+	}
+	p.listeners = nil
+}
+
+// Validate makes sure all the ports are available. The explorer port is only
+// checked if explorer is true.
+func (p *PortMapper) Validate(explorer bool) error {
+	type namedPort struct {
+		name string
+		port int
+	}
+	ports := []namedPort{}
+	if explorer {
+		ports = append(ports, namedPort{"explorer", p.Explorer})
+	}
+	ports = append(ports,
+		namedPort{"tendermint rpc", p.TendermintRPC},
+		namedPort{"tendermint p2p", p.TendermintP2P},
+		namedPort{"ipfs", p.IPFS},
+	)
+
+	inUse := []string{}
+	for _, entry := range ports {
+		if !portAvailable(entry.port) {
+			inUse = append(inUse, fmt.Sprintf("port %d (%s)", entry.port, entry.name))
+		}
+	}
+	if len(inUse) > 0 {
+		return fmt.Errorf("%s already in use", strings.Join(inUse, ", "))
+	}
+	return nil
+}
 
-		// Double check by also attempting a connection.
-		c, err := net.Dial("tcp", fmt.Sprintf(":%d", port))
-		if err == nil {
-			c.Close()
-			return false
+// reservePortRange listens on n ports starting from base, skipping the
+// explorer port unless explorer is true. Returns false if any of them is
+// unavailable.
+func reservePortRange(base, n int, explorer bool) ([]net.Listener, bool) {
+	listeners := make([]net.Listener, 0, n)
+	release := func() {
+		for _, l := range listeners {
+			l.Close()
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		if i == explorerOffset && !explorer {
+			continue
+		}
+		if !portAvailable(base + i) {
+			release()
+			return nil, false
+		}
+		l, err := net.Listen("tcp", fmt.Sprintf(":%d", base+i))
+		if err != nil {
+			release()
+			return nil, false
 		}
+		listeners = append(listeners, l)
+	}
+
+	return listeners, true
+}
+
+func portAvailable(port int) bool {
+	// We are dialing in addition to listening because for some reason,
+	// if the port is being used by a container, it will listen just fine
+	// rather than throwing an address already in use.
+
+	// First, try to listen to that port.
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return false
+	}
+	l.Close()
+
+	// Double check by also attempting a connection.
+	c, err := net.Dial("tcp", fmt.Sprintf(":%d", port))
+	if err == nil {
+		c.Close()
+		return false
 	}
 
 	return true
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"testing"
+)
+
+func TestAllocatePortsWithoutExplorer(t *testing.T) {
+	// Find a free range, then occupy its explorer port.
+	ports, err := AllocatePortsFrom(BasePortDefault, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ports.Release()
+	base := ports.Explorer
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", base))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	ports, err = AllocatePortsFrom(base, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ports.Release()
+	if ports.Explorer != 0 {
+		t.Errorf("explorer port %d allocated", ports.Explorer)
+	}
+	if ports.TendermintRPC != base+1 {
+		t.Errorf("range starting at %d skipped for a busy explorer port", base)
+	}
+	if err := ports.Validate(false); err != nil {
+		t.Errorf("busy explorer port checked: %v", err)
+	}
+
+	ports.EnableExplorer()
+	if ports.Explorer != base {
+		t.Errorf("explorer port %d, want %d", ports.Explorer, base)
+	}
+	if err := ports.Validate(true); err == nil {
+		t.Error("busy explorer port not reported")
+	}
+}
@@ -0,0 +1,88 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+
+	cid "github.com/ipsn/go-ipfs/gxlibs/github.com/ipfs/go-cid"
+	"github.com/pkg/errors"
+)
+
+// aliasesFile is the name of the local alias store within the root directory.
+const aliasesFile = "aliases.json"
+
+// PublishAlias registers alias as a human-readable name for chainID.
+func (s *Server) PublishAlias(ctx context.Context, alias, chainID string) error {
+	if alias == "" {
+		return errors.New("alias cannot be empty")
+	}
+	if _, err := cid.Decode(alias); err == nil {
+		return fmt.Errorf("alias %q cannot be a chain ID", alias)
+	}
+	if _, err := cid.Decode(chainID); err != nil {
+		return errors.Wrapf(err, "invalid chain ID %q", chainID)
+	}
+
+	aliases, err := s.loadAliases()
+	if err != nil {
+		return err
+	}
+	if existing, ok := aliases[alias]; ok {
+		if existing == chainID {
+			return nil
+		}
+		return fmt.Errorf("alias %q is already registered for network %s", alias, existing)
+	}
+	aliases[alias] = chainID
+
+	data, err := json.MarshalIndent(aliases, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path.Dir(s.aliasesPath()), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(s.aliasesPath(), data, 0644); err != nil {
+		return errors.Wrap(err, "unable to write aliases file")
+	}
+	return s.recordNetwork(chainID, alias)
+}
+
+// ResolveAlias returns the chain ID registered for name. If name isn't a
+// known alias, it is returned as is.
+func (s *Server) ResolveAlias(name string) (string, error) {
+	aliases, err := s.loadAliases()
+	if err != nil {
+		return "", err
+	}
+	if chainID, ok := aliases[name]; ok {
+		return chainID, nil
+	}
+	return name, nil
+}
+
+func (s *Server) aliasesPath() string {
+	if s.aliasesFile != "" {
+		return s.aliasesFile
+	}
+	return path.Join(s.root, aliasesFile)
+}
+
+func (s *Server) loadAliases() (map[string]string, error) {
+	aliases := make(map[string]string)
+	data, err := ioutil.ReadFile(s.aliasesPath())
+	if os.IsNotExist(err) {
+		return aliases, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read aliases file")
+	}
+	if err := json.Unmarshal(data, &aliases); err != nil {
+		return nil, errors.Wrap(err, "unable to parse aliases file")
+	}
+	return aliases, nil
+}
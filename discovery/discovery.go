@@ -1,14 +1,25 @@
 package discovery
 
 import (
+	"archive/tar"
+	"bufio"
 	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/blocklayerhq/chainkit/project"
@@ -16,14 +27,23 @@ import (
 	"github.com/ipsn/go-ipfs/core"
 	"github.com/ipsn/go-ipfs/core/coreapi"
 	iface "github.com/ipsn/go-ipfs/core/coreapi/interface"
+	"github.com/ipsn/go-ipfs/core/coreapi/interface/options"
+	"github.com/ipsn/go-ipfs/core/corerepo"
 	cid "github.com/ipsn/go-ipfs/gxlibs/github.com/ipfs/go-cid"
 	iaddr "github.com/ipsn/go-ipfs/gxlibs/github.com/ipfs/go-ipfs-addr"
 	config "github.com/ipsn/go-ipfs/gxlibs/github.com/ipfs/go-ipfs-config"
 	"github.com/ipsn/go-ipfs/gxlibs/github.com/ipfs/go-ipfs-files"
+	ci "github.com/ipsn/go-ipfs/gxlibs/github.com/libp2p/go-libp2p-crypto"
 	"github.com/ipsn/go-ipfs/gxlibs/github.com/libp2p/go-libp2p-kad-dht"
+	dhtopts "github.com/ipsn/go-ipfs/gxlibs/github.com/libp2p/go-libp2p-kad-dht/opts"
 	net "github.com/ipsn/go-ipfs/gxlibs/github.com/libp2p/go-libp2p-net"
+	peer "github.com/ipsn/go-ipfs/gxlibs/github.com/libp2p/go-libp2p-peer"
 	pstore "github.com/ipsn/go-ipfs/gxlibs/github.com/libp2p/go-libp2p-peerstore"
+	pnet "github.com/ipsn/go-ipfs/gxlibs/github.com/libp2p/go-libp2p-pnet"
+	p2pdiscovery "github.com/ipsn/go-ipfs/gxlibs/github.com/libp2p/go-libp2p/p2p/discovery"
 	"github.com/ipsn/go-ipfs/gxlibs/github.com/multiformats/go-multiaddr"
+	mh "github.com/ipsn/go-ipfs/gxlibs/github.com/multiformats/go-multihash"
+	"github.com/ipsn/go-ipfs/pin/gc"
 	"github.com/ipsn/go-ipfs/plugin/loader"
 	"github.com/ipsn/go-ipfs/repo/fsrepo"
 	"github.com/pkg/errors"
@@ -31,11 +51,50 @@ import (
 
 const (
 	nBitsForKeypairDefault = 4096
+	nBitsForKeypairMin     = 1024
+
+	// swarmKeyFile is the name of the private swarm key in the repository.
+	swarmKeyFile = "swarm.key"
+
+	bootstrapMaxAttemptsDefault = 5
+	bootstrapRetryDelayDefault  = 1 * time.Second
+
+	timeoutDefault = 10 * time.Second
+
+	queryConcurrencyDefault = 8
+	maxProvidersDefault     = 10
+
+	connLowWaterDefault    = 100
+	connHighWaterDefault   = 400
+	connGracePeriodDefault = 30 * time.Second
+
+	// peerTag is the connection manager tag keeping explicit peers
+	// connected.
+	peerTag = "bitcoinx-peer"
+
+	// drainTimeout is how long Stop waits for in-flight streams.
+	drainTimeout = 5 * time.Second
+
+	// watchPeersInterval is the delay between two lookups in WatchPeers.
+	watchPeersInterval = 5 * time.Second
+
+	// reconnectThreshold is the number of connected peers under which the
+	// bootstrap and explicit peers get dialed again.
+	reconnectIntervalDefault = 30 * time.Second
+	reconnectThreshold       = 4
 )
 
 var (
-	// IPFS bootstrap nodes. Used to find other peers in the network.
-	bootstrapPeers = []string{
+	// ErrNotConnected is returned when no bootstrap peer could be reached.
+	ErrNotConnected = errors.New("not connected to any bootstrap peers")
+
+	// ErrOffline is returned by the operations needing the network when
+	// the server runs offline.
+	ErrOffline = errors.New("discovery is offline")
+
+	// DefaultBootstrapPeers are the IPFS bootstrap nodes used to find other
+	// peers in the network when none are configured.
+	DefaultBootstrapPeers = []string{
 		"/ip4/104.131.131.82/tcp/4001/ipfs/QmaCpDMGvV2BGHeYERUEnRQAwe3N8SzbUtfsmvsqQLuvuJ",
 		"/ip4/104.236.179.241/tcp/4001/ipfs/QmSoLPppuBtQSGwKDZT2M73ULpjvfd3aZ6ha4oFGL1KrGM",
 		"/ip4/104.236.76.40/tcp/4001/ipfs/QmSoLV4Bbm51jM9C4gDYZQ9Cy3U6aXMJDAbzgu2fzaDs64",
@@ -51,16 +110,84 @@ type PeerInfo struct {
 	TendermintP2PPort int      `json:"tendermint_p2p_port"`
 }
 
+// NewPeerInfo returns the information announced for the node with the given
+// Tendermint node ID, reachable on the given Tendermint P2P port.
+func NewPeerInfo(nodeID string, port int) *PeerInfo {
+	return &PeerInfo{
+		NodeID:            nodeID,
+		TendermintP2PPort: port,
+	}
+}
+
 // NetworkInfo represents a network.
+//
+// Image streams the image from IPFS: the NetworkInfo owns it and the caller
+// must call Close (or WriteImage) once done with the network.
 type NetworkInfo struct {
+	ChainID  string
 	Manifest []byte
 	Genesis  []byte
 	Image    io.ReadCloser
+
+	// project is the parsed manifest.
+	project *project.Project
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// Close releases the image. It is safe to call multiple times.
+func (n *NetworkInfo) Close() error {
+	n.closeOnce.Do(func() {
+		if n.Image != nil {
+			n.closeErr = n.Image.Close()
+		}
+	})
+	return n.closeErr
 }
 
 // Project returns a project object from the network info.
 func (n *NetworkInfo) Project() (*project.Project, error) {
-	return project.Parse(bytes.NewReader(n.Manifest))
+	if n.project != nil {
+		return n.project, nil
+	}
+	p, err := project.Parse(bytes.NewReader(n.Manifest))
+	if err != nil {
+		return nil, err
+	}
+	n.project = p
+	return p, nil
+}
+
+// Name returns the name of the network project, or the chain ID if the
+// manifest can't be parsed.
+func (n *NetworkInfo) Name() string {
+	p, err := n.Project()
+	if err != nil {
+		return n.ChainID
+	}
+	return p.Name
+}
+
+// ImageName returns the name of the container image of the network, or an
+// empty string if the manifest can't be parsed.
+func (n *NetworkInfo) ImageName() string {
+	p, err := n.Project()
+	if err != nil {
+		return ""
+	}
+	return p.Image
+}
+
+// GenesisHash returns the hex encoded SHA-256 of the genesis file.
+//
+// The network content is addressed by the chain ID, so Join already
+// guarantees the genesis is the one published for the chain. The hash makes
+// it easy for operators to compare genesis files out of band, for instance
+// across peers or against the one announced by the network publisher.
+func (n *NetworkInfo) GenesisHash() string {
+	sum := sha256.Sum256(n.Genesis)
+	return hex.EncodeToString(sum[:])
 }
 
 // WriteManifest writes the manifest file to dst
@@ -71,37 +198,487 @@ func (n *NetworkInfo) WriteManifest(dst string) error {
 	return nil
 }
 
+// WriteGenesis writes the genesis file to dst
+func (n *NetworkInfo) WriteGenesis(dst string) error {
+	if err := ioutil.WriteFile(dst, n.Genesis, 0644); err != nil {
+		return errors.Wrap(err, "unable to write genesis file")
+	}
+	return nil
+}
+
+// WriteImage streams the image to dst as an uncompressed tarball, then
+// closes the network. It can only be called once.
+func (n *NetworkInfo) WriteImage(dst string) error {
+	defer n.Close()
+
+	f, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return errors.Wrap(err, "unable to write image file")
+	}
+	if _, err := io.Copy(f, n.Image); err != nil {
+		f.Close()
+		os.Remove(dst)
+		return errors.Wrap(err, "unable to write image file")
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(dst)
+		return errors.Wrap(err, "unable to write image file")
+	}
+	return nil
+}
+
+// KeyType is the type of key of the IPFS identity.
+//
+// RSA keys are supported by every IPFS peer, but generating one is slow:
+// with the default 4096 bits, it can take minutes on low-end hardware.
+// Ed25519 keys are generated instantly and make for smaller handshakes, but
+// are not supported by very old IPFS peers. The key type only matters when
+// the repository gets initialized: existing identities are kept.
+type KeyType string
+
+const (
+	// KeyTypeRSA generates an RSA identity. This is the default.
+	KeyTypeRSA KeyType = "rsa"
+	// KeyTypeEd25519 generates an Ed25519 identity.
+	KeyTypeEd25519 KeyType = "ed25519"
+)
+
+// Routing is how the node takes part in the IPFS DHT.
+type Routing string
+
+const (
+	// RoutingDHT fully takes part in the DHT. This is the default.
+	RoutingDHT Routing = "dht"
+	// RoutingDHTClient queries the DHT without serving records to others.
+	RoutingDHTClient Routing = "dhtclient"
+	// RoutingNone stays out of the DHT: networks are only looked up from
+	// the peers added with AddPeer, the bootstrap peers and the local
+	// peers found with mDNS. The public DefaultBootstrapPeers are not
+	// used.
+	RoutingNone Routing = "none"
+)
+
+// Options contains the discovery server options.
+type Options struct {
+	// BootstrapPeers is a list of IPFS multiaddrs used to join the swarm.
+	// Defaults to DefaultBootstrapPeers if empty.
+	BootstrapPeers []string
+
+	// BootstrapMaxAttempts is the number of connection attempts made to
+	// each bootstrap peer before giving up.
+	BootstrapMaxAttempts int
+
+	// BootstrapRetryDelay is the delay before retrying a failed bootstrap
+	// connection. It doubles after every failed attempt.
+	BootstrapRetryDelay time.Duration
+
+	// AliasesFile is the path of the local alias store.
+	// Defaults to a file within the root directory.
+	AliasesFile string
+
+	// IndexFile is the path of the index of known networks.
+	// Defaults to a file within the root directory.
+	IndexFile string
+
+	// EnableMDNS enables discovery of peers on the local network.
+	EnableMDNS bool
+
+	// MaxProviders is the maximum number of providers returned by a single
+	// peers lookup. A higher limit finds more nodes of large networks, but
+	// the lookup takes longer to complete. Defaults to 10.
+	MaxProviders int
+
+	// QueryConcurrency is the number of peers queried concurrently when
+	// looking for network nodes. Defaults to 8.
+	QueryConcurrency int
+
+	// Observe makes the node consume network information without being
+	// discoverable: Announce neither provides the network in the DHT nor
+	// serves the node information to other peers.
+	Observe bool
+
+	// Timeout bounds a single Announce or peers lookup. A shorter deadline
+	// set on the caller's context takes precedence.
+	// Defaults to 10 seconds.
+	Timeout time.Duration
+
+	// CompressionLevel is the gzip level used to compress uncompressed
+	// images on Publish. Defaults to gzip.DefaultCompression.
+	CompressionLevel int
+
+	// SwarmKey is the content of an IPFS swarm key file. When set, the node
+	// only connects to the private swarm of peers sharing the key, and the
+	// public DefaultBootstrapPeers are not used: BootstrapPeers should list
+	// private swarm members. Without bootstrap peers, the node starts
+	// alone, as the first member of the swarm.
+	SwarmKey []byte
+
+	// KeyType is the type of the identity key generated when initializing
+	// the repository. Defaults to KeyTypeRSA.
+	KeyType KeyType
+
+	// KeyBits is the size of RSA identity keys. Defaults to 4096.
+	KeyBits int
+
+	// Routing sets how the node takes part in the IPFS DHT.
+	// Defaults to RoutingDHT.
+	Routing Routing
+
+	// ConnLowWater and ConnHighWater bound the number of IPFS connections:
+	// once there are more than ConnHighWater connections, the oldest are
+	// closed until ConnLowWater remain. Peers added with AddPeer are kept.
+	// Default to 100 and 400.
+	ConnLowWater  int
+	ConnHighWater int
+
+	// ConnGracePeriod is how long new connections are exempt from being
+	// closed by the connection limits. Defaults to 30 seconds.
+	ConnGracePeriod time.Duration
+
+	// ReconnectInterval is how often the connectivity is checked once
+	// started. The bootstrap peers and the peers added with AddPeer are
+	// dialed again when too few peers remain connected, including when
+	// none of them could be reached on Start. Defaults to 30 seconds.
+	ReconnectInterval time.Duration
+
+	// Offline starts the node without connecting to the IPFS network.
+	// Join then only reads the networks available in the local repository,
+	// which is useful to inspect the networks already fetched. Operations
+	// needing the network return ErrOffline.
+	Offline bool
+
+	// GatewayPort enables a read-only HTTP gateway to the IPFS content on
+	// the given localhost port, to fetch the network files with plain HTTP.
+	// Disabled if zero.
+	GatewayPort int
+
+	// Metrics receives measurements of the server activity. Defaults to
+	// discarding them.
+	Metrics Metrics
+
+	// Namespace isolates the networks of an application or deployment:
+	// the node information is only exchanged, and the DHT provider records
+	// only looked up, within the namespace. Nodes running the same binary
+	// with different namespaces don't see each other. Defaults to the
+	// global namespace, shared with older versions.
+	Namespace string
+
+	// Identity is a private key, as returned by ExportIdentity, used as
+	// the identity when initializing the repository instead of generating
+	// a new one. Restoring a backed up identity keeps the peer ID stable.
+	Identity []byte
+}
+
+// BootstrapResult is the outcome of the connection to a bootstrap peer.
+type BootstrapResult struct {
+	// Peer is the ID of the bootstrap peer.
+	Peer string
+	// Err is nil if the connection succeeded.
+	Err error
+}
+
 // Server is the discovery server
 type Server struct {
-	root string
-	port int
-	node *core.IpfsNode
+	root             string
+	port             int
+	bootstrapPeers   []*pstore.PeerInfo
+	maxAttempts      int
+	retryDelay       time.Duration
+	reconnect        time.Duration
+	aliasesFile      string
+	indexFile        string
+	timeout          time.Duration
+	queryConcurrency int
+	maxProviders     int
+	compressionLevel int
+	swarmKey         []byte
+	keyType          KeyType
+	keyBits          int
+	identity         []byte
+	routing          Routing
+	namespace        string
+	metrics          Metrics
+	connMgr          config.ConnMgr
+	node             *core.IpfsNode
 
 	dht         *dht.IpfsDHT
 	connectedCh chan (struct{})
+	readyOnce   sync.Once
+	connected   int32
+
+	// bootstrapResults is filled by dhtConnect, which closes
+	// bootstrapDone once every bootstrap peer has been tried.
+	bootstrapResults []BootstrapResult
+	bootstrapMu      sync.Mutex
+	bootstrapDone    chan struct{}
+
+	// streams tracks the stream handlers currently serving peers. Once
+	// stopping is set, no stream gets added anymore so that Stop can wait
+	// for the counter to drop to zero.
+	streams   sync.WaitGroup
+	streamsMu sync.Mutex
+	stopping  bool
+
+	// peers are the peers explicitly added with AddPeer.
+	peers   []pstore.PeerInfo
+	peersMu sync.Mutex
+
+	// announced maps the announced chain IDs to the node information
+	// served for them.
+	announced    map[string]*PeerInfo
+	announcedMu  sync.Mutex
+	handlersOnce sync.Once
+
+	enableMDNS bool
+	mdns       p2pdiscovery.Service
+
+	// cancel stops the background tasks started by Start.
+	cancel context.CancelFunc
+
+	gatewayPort int
+	gateway     *http.Server
+	gatewayAddr string
+
+	observe bool
+	offline bool
 
 	api iface.CoreAPI
 }
 
 // New returns a new discovery server
-func New(root string, port int) *Server {
+func New(root string, port int, opts Options) (*Server, error) {
+	routing := opts.Routing
+	switch routing {
+	case "":
+		routing = RoutingDHT
+	case RoutingDHT, RoutingDHTClient, RoutingNone:
+	default:
+		return nil, fmt.Errorf("unsupported routing %q", routing)
+	}
+
+	peers := opts.BootstrapPeers
+	if len(opts.SwarmKey) > 0 {
+		if _, err := pnet.NewProtector(bytes.NewReader(opts.SwarmKey)); err != nil {
+			return nil, errors.Wrap(err, "invalid swarm key")
+		}
+	} else if len(peers) == 0 && routing != RoutingNone {
+		peers = DefaultBootstrapPeers
+	}
+
+	bootstrapPeers, err := parseBootstrapPeers(peers)
+	if err != nil {
+		return nil, err
+	}
+
+	maxAttempts := opts.BootstrapMaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = bootstrapMaxAttemptsDefault
+	}
+	retryDelay := opts.BootstrapRetryDelay
+	if retryDelay <= 0 {
+		retryDelay = bootstrapRetryDelayDefault
+	}
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = timeoutDefault
+	}
+	reconnect := opts.ReconnectInterval
+	if reconnect <= 0 {
+		reconnect = reconnectIntervalDefault
+	}
+	queryConcurrency := opts.QueryConcurrency
+	if queryConcurrency <= 0 {
+		queryConcurrency = queryConcurrencyDefault
+	}
+	maxProviders := opts.MaxProviders
+	if maxProviders <= 0 {
+		maxProviders = maxProvidersDefault
+	}
+	keyType := opts.KeyType
+	switch keyType {
+	case "":
+		keyType = KeyTypeRSA
+	case KeyTypeRSA, KeyTypeEd25519:
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", keyType)
+	}
+	keyBits := opts.KeyBits
+	if keyBits <= 0 {
+		keyBits = nBitsForKeypairDefault
+	}
+	if keyType == KeyTypeRSA && keyBits < nBitsForKeypairMin {
+		return nil, fmt.Errorf("RSA keys of less than %d bits are unsafe", nBitsForKeypairMin)
+	}
+	if len(opts.Identity) > 0 {
+		if _, err := ci.UnmarshalPrivateKey(opts.Identity); err != nil {
+			return nil, errors.Wrap(err, "invalid identity")
+		}
+	}
+	connLowWater := opts.ConnLowWater
+	if connLowWater <= 0 {
+		connLowWater = connLowWaterDefault
+	}
+	connHighWater := opts.ConnHighWater
+	if connHighWater <= 0 {
+		connHighWater = connHighWaterDefault
+	}
+	if connHighWater < connLowWater {
+		return nil, fmt.Errorf("connection high water (%d) is lower than low water (%d)", connHighWater, connLowWater)
+	}
+	connGracePeriod := opts.ConnGracePeriod
+	if connGracePeriod <= 0 {
+		connGracePeriod = connGracePeriodDefault
+	}
+	if strings.ContainsAny(opts.Namespace, "/ ") {
+		return nil, fmt.Errorf("invalid namespace %q: must not contain slashes or spaces", opts.Namespace)
+	}
+	metrics := opts.Metrics
+	if metrics == nil {
+		metrics = nopMetrics{}
+	}
+	compressionLevel := opts.CompressionLevel
+	if compressionLevel == 0 {
+		compressionLevel = gzip.DefaultCompression
+	}
+
 	return &Server{
-		root:        root,
-		port:        port,
-		connectedCh: make(chan struct{}),
+		root:             root,
+		port:             port,
+		bootstrapPeers:   bootstrapPeers,
+		maxAttempts:      maxAttempts,
+		retryDelay:       retryDelay,
+		reconnect:        reconnect,
+		aliasesFile:      opts.AliasesFile,
+		indexFile:        opts.IndexFile,
+		timeout:          timeout,
+		compressionLevel: compressionLevel,
+		swarmKey:         opts.SwarmKey,
+		keyType:          keyType,
+		keyBits:          keyBits,
+		identity:         opts.Identity,
+		routing:          routing,
+		namespace:        opts.Namespace,
+		offline:          opts.Offline,
+		gatewayPort:      opts.GatewayPort,
+		metrics:          metrics,
+		connMgr: config.ConnMgr{
+			Type:        "basic",
+			LowWater:    connLowWater,
+			HighWater:   connHighWater,
+			GracePeriod: connGracePeriod.String(),
+		},
+		enableMDNS:       opts.EnableMDNS,
+		observe:          opts.Observe,
+		queryConcurrency: queryConcurrency,
+		maxProviders:     maxProviders,
+		connectedCh:      make(chan struct{}),
+		bootstrapDone:    make(chan struct{}),
+	}, nil
+}
+
+// parseBootstrapPeers parses and validates a list of bootstrap multiaddrs.
+func parseBootstrapPeers(peers []string) ([]*pstore.PeerInfo, error) {
+	infos := make([]*pstore.PeerInfo, 0, len(peers))
+	for _, peerAddr := range peers {
+		peerinfo, err := parsePeer(peerAddr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid bootstrap peer %q", peerAddr)
+		}
+		infos = append(infos, peerinfo)
+	}
+	return infos, nil
+}
+
+// parsePeer parses a multiaddr ending with the peer ID ("/ipfs/<id>").
+func parsePeer(peerAddr string) (*pstore.PeerInfo, error) {
+	addr, err := iaddr.ParseString(peerAddr)
+	if err != nil {
+		return nil, err
 	}
+	return pstore.InfoFromP2pAddr(addr.Multiaddr())
 }
 
 // Stop must be called after start
 func (s *Server) Stop() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.stopGateway()
+	if s.mdns != nil {
+		s.mdns.Close()
+	}
+
+	// Stop accepting new streams and let the in-flight ones complete.
+	if s.node.PeerHost != nil {
+		for _, id := range protocolIDs(s.namespace) {
+			s.node.PeerHost.RemoveStreamHandler(id)
+		}
+	}
+	if !s.drainStreams(drainTimeout) {
+		ui.Error("Timed out waiting for peer streams to complete")
+	}
+
 	return s.node.Close()
 }
 
+// drainStreams rejects new streams and waits for the in-flight ones to
+// complete. Returns false if they didn't within timeout.
+func (s *Server) drainStreams(timeout time.Duration) bool {
+	s.streamsMu.Lock()
+	s.stopping = true
+	s.streamsMu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		defer close(drained)
+		s.streams.Wait()
+	}()
+	select {
+	case <-drained:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// trackStream registers a new stream handler. Returns false if the server
+// is stopping, in which case the stream must not be served.
+func (s *Server) trackStream() bool {
+	s.streamsMu.Lock()
+	defer s.streamsMu.Unlock()
+	if s.stopping {
+		return false
+	}
+	s.streams.Add(1)
+	return true
+}
+
+var (
+	pluginsOnce sync.Once
+	pluginsErr  error
+)
+
+// loadPlugins loads the IPFS plugins. They register globally, so only the
+// first server of the process loads them.
+func loadPlugins(dir string) error {
+	pluginsOnce.Do(func() {
+		_, pluginsErr = loader.LoadPlugins(dir)
+	})
+	return pluginsErr
+}
+
 // Start starts the discovery server
 func (s *Server) Start(ctx context.Context) error {
 	ui.Info("Initializing node...")
 
-	daemonLocked, err := fsrepo.LockedByOtherProcess(s.root)
+	stale, err := RepoLockStale(s.root)
+	if err != nil {
+		return err
+	}
+	if stale {
+		return fmt.Errorf("%q is locked by a process which is gone, run `bitcoinx doctor --fix-lock` to remove the stale lock", s.root)
+	}
+	daemonLocked, err := RepoLocked(s.root)
 	if err != nil {
 		return err
 	}
@@ -109,8 +686,7 @@ func (s *Server) Start(ctx context.Context) error {
 		return fmt.Errorf("another instance is already accessing %q", s.root)
 	}
 
-	plugins := path.Join(s.root, "plugins")
-	if _, err = loader.LoadPlugins(plugins); err != nil {
+	if err := loadPlugins(path.Join(s.root, "plugins")); err != nil {
 		return err
 	}
 
@@ -118,6 +694,20 @@ func (s *Server) Start(ctx context.Context) error {
 		if err := s.ipfsInit(); err != nil {
 			return err
 		}
+	} else if len(s.identity) > 0 {
+		// The identity is only used when initializing.
+		if ident, err := importIdentity(s.identity); err == nil {
+			if conf, err := fsrepo.ConfigAt(s.root); err == nil && conf.Identity.PeerID != ident.PeerID {
+				ui.Warn("Ignoring identity %s, the repository already has identity %s", ident.PeerID, conf.Identity.PeerID)
+			}
+		}
+	}
+
+	// The IPFS node picks up the swarm key from the repository.
+	if len(s.swarmKey) > 0 {
+		if err := ioutil.WriteFile(path.Join(s.root, swarmKeyFile), s.swarmKey, 0600); err != nil {
+			return errors.Wrap(err, "unable to write swarm key")
+		}
 	}
 
 	repo, err := fsrepo.Open(s.root)
@@ -132,28 +722,96 @@ func (s *Server) Start(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if err := repo.SetConfigKey("Swarm.ConnMgr", s.connMgr); err != nil {
+		return err
+	}
+	// Local discovery is handled by startMDNS, only when enabled.
+	if err := repo.SetConfigKey("Discovery.MDNS.Enabled", false); err != nil {
+		return err
+	}
+
+	if s.offline {
+		s.node, err = core.NewNode(ctx, &core.BuildCfg{
+			Online: false,
+			Repo:   repo,
+		})
+		if err != nil {
+			return err
+		}
+		s.api = coreapi.NewCoreAPI(s.node)
+		close(s.bootstrapDone)
+		if s.gatewayPort != 0 {
+			return s.startGateway(s.gatewayPort)
+		}
+		return nil
+	}
 
-	s.node, err = core.NewNode(ctx, &core.BuildCfg{
+	cfg := &core.BuildCfg{
 		Online: true,
 		Repo:   repo,
-	})
+	}
+	switch s.routing {
+	case RoutingDHTClient:
+		cfg.Routing = core.DHTClientOption
+	case RoutingNone:
+		cfg.Routing = core.NilRouterOption
+	}
+	s.node, err = core.NewNode(ctx, cfg)
 	if err != nil {
 		return err
 	}
 
 	s.api = coreapi.NewCoreAPI(s.node)
-	s.dht, err = dht.New(ctx, s.node.PeerHost)
-	if err != nil {
-		return err
+	if s.routing != RoutingNone {
+		s.dht, err = dht.New(ctx, s.node.PeerHost, dhtopts.Client(s.routing == RoutingDHTClient))
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.enableMDNS {
+		if err := s.startMDNS(ctx); err != nil {
+			return err
+		}
+	}
+
+	if s.gatewayPort != 0 {
+		if err := s.startGateway(s.gatewayPort); err != nil {
+			return err
+		}
 	}
 
 	go s.dhtConnect(ctx)
 
-	return nil
+	var bgctx context.Context
+	bgctx, s.cancel = context.WithCancel(ctx)
+	go s.maintainConnections(bgctx)
+
+	// Wait for the first bootstrap connection (or for all of them to fail).
+	return s.waitConnected(ctx)
 }
 
 func (s *Server) ipfsInit() error {
-	conf, err := config.Init(os.Stdout, nBitsForKeypairDefault)
+	var (
+		conf *config.Config
+		err  error
+	)
+	switch {
+	case len(s.identity) > 0:
+		// config.Init always generates an RSA identity. Keep it cheap
+		// since it gets replaced.
+		conf, err = config.Init(ioutil.Discard, nBitsForKeypairMin)
+		if err == nil {
+			conf.Identity, err = importIdentity(s.identity)
+		}
+	case s.keyType == KeyTypeRSA:
+		conf, err = config.Init(os.Stdout, s.keyBits)
+	default:
+		conf, err = config.Init(ioutil.Discard, nBitsForKeypairMin)
+		if err == nil {
+			conf.Identity, err = newIdentity(ci.Ed25519)
+		}
+	}
 	if err != nil {
 		return err
 	}
@@ -163,129 +821,971 @@ func (s *Server) ipfsInit() error {
 	return fsrepo.Init(s.root, conf)
 }
 
-func (s *Server) dhtConnect(ctx context.Context) {
-	defer close(s.connectedCh)
-	for _, peerAddr := range bootstrapPeers {
-		addr, _ := iaddr.ParseString(peerAddr)
-		peerinfo, _ := pstore.InfoFromP2pAddr(addr.Multiaddr())
-
-		err := s.node.PeerHost.Connect(ctx, *peerinfo)
-		if err != nil {
-			ui.Error("Connection with bootstrap node %v failed: %v", *peerinfo, err)
-			continue
-		}
+// newIdentity generates an identity with a key of the given type.
+func newIdentity(typ int) (config.Identity, error) {
+	sk, _, err := ci.GenerateKeyPair(typ, 0)
+	if err != nil {
+		return config.Identity{}, err
 	}
+	return identityFromKey(sk)
 }
 
-// Publish publishes chain information. Returns the chain ID.
-func (s *Server) Publish(ctx context.Context, manifestPath, genesisPath, imagePath string) (string, error) {
-	sandbox, err := ioutil.TempDir(os.TempDir(), "chainkit-network")
+// importIdentity returns the identity of a private key exported with
+// ExportIdentity.
+func importIdentity(data []byte) (config.Identity, error) {
+	sk, err := ci.UnmarshalPrivateKey(data)
 	if err != nil {
-		return "", err
+		return config.Identity{}, errors.Wrap(err, "invalid identity")
 	}
+	return identityFromKey(sk)
+}
 
-	st, err := os.Stat(sandbox)
+func identityFromKey(sk ci.PrivKey) (config.Identity, error) {
+	ident := config.Identity{}
+	skbytes, err := sk.Bytes()
 	if err != nil {
-		return "", err
+		return ident, err
+	}
+	id, err := peer.IDFromPrivateKey(sk)
+	if err != nil {
+		return ident, err
 	}
+	ident.PrivKey = base64.StdEncoding.EncodeToString(skbytes)
+	ident.PeerID = id.Pretty()
+	return ident, nil
+}
 
-	if err := os.Link(manifestPath, path.Join(sandbox, "chainkit.yml")); err != nil {
-		return "", err
+// ExportIdentity returns the private key of the node identity, to be backed
+// up and restored with Options.Identity. The repository must be
+// initialized, which Start takes care of.
+func (s *Server) ExportIdentity() ([]byte, error) {
+	conf, err := fsrepo.ConfigAt(s.root)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read repository config")
 	}
-	if err := os.Link(genesisPath, path.Join(sandbox, "genesis.json")); err != nil {
-		return "", err
+	if conf.Identity.PrivKey == "" {
+		return nil, errors.New("repository has no identity")
 	}
-	if err := os.Link(imagePath, path.Join(sandbox, "image.tgz")); err != nil {
-		return "", err
+	data, err := base64.StdEncoding.DecodeString(conf.Identity.PrivKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid identity")
 	}
+	return data, nil
+}
 
-	f, err := files.NewSerialFile("network", sandbox, false, st)
-	if err != nil {
-		return "", err
+// dhtConnect connects to the bootstrap peers. connectedCh is closed as soon as
+// one connection succeeds, or once every peer has failed. The outcome of
+// every connection is available from BootstrapResults.
+func (s *Server) dhtConnect(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, peerinfo := range s.bootstrapPeers {
+		wg.Add(1)
+		go func(peerinfo *pstore.PeerInfo) {
+			defer wg.Done()
+			err := s.bootstrapConnect(ctx, peerinfo)
+			s.metrics.Bootstrap(err)
+
+			s.bootstrapMu.Lock()
+			s.bootstrapResults = append(s.bootstrapResults, BootstrapResult{
+				Peer: peerinfo.ID.Pretty(),
+				Err:  err,
+			})
+			s.bootstrapMu.Unlock()
+
+			if err == nil {
+				s.markConnected()
+			}
+		}(peerinfo)
 	}
+	wg.Wait()
+	close(s.bootstrapDone)
+	s.readyOnce.Do(func() { close(s.connectedCh) })
+}
 
-	p, err := s.api.Unixfs().Add(ctx, f)
-	if err != nil {
-		return "", err
+// BootstrapResults waits until every bootstrap peer has been tried and
+// returns the outcome of each connection. Start only waits for the first
+// successful connection, so this is the way to find out about failures.
+// Only valid after Start.
+func (s *Server) BootstrapResults(ctx context.Context) ([]BootstrapResult, error) {
+	select {
+	case <-s.bootstrapDone:
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
 
-	return p.Cid().String(), nil
+	s.bootstrapMu.Lock()
+	defer s.bootstrapMu.Unlock()
+	return append([]BootstrapResult(nil), s.bootstrapResults...), nil
 }
 
-// Join joins a network.
-func (s *Server) Join(ctx context.Context, chainID string) (*NetworkInfo, error) {
-	manifestPath, err := iface.ParsePath(path.Join("/ipfs", chainID, "chainkit.yml"))
-	if err != nil {
-		return nil, err
-	}
-	manifestFile, err := s.api.Unixfs().Get(ctx, manifestPath)
-	if err != nil {
-		return nil, err
+// maintainConnections periodically makes sure the node is still connected
+// to the network, dialing the bootstrap and explicit peers again when too few
+// peers remain, until the context is cancelled.
+func (s *Server) maintainConnections(ctx context.Context) {
+	// Let the initial bootstrap complete first.
+	select {
+	case <-s.bootstrapDone:
+	case <-ctx.Done():
+		return
 	}
-	manifestData, err := ioutil.ReadAll(manifestFile)
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to read genesis file")
+
+	for {
+		select {
+		case <-time.After(s.reconnect):
+		case <-ctx.Done():
+			return
+		}
+
+		host := s.node.PeerHost
+		connected := len(host.Network().Peers())
+		if connected >= reconnectThreshold {
+			continue
+		}
+
+		s.peersMu.Lock()
+		peers := append([]pstore.PeerInfo(nil), s.peers...)
+		s.peersMu.Unlock()
+		for _, p := range s.bootstrapPeers {
+			peers = append(peers, *p)
+		}
+
+		ui.Verbose("Only %d peers connected, reconnecting", connected)
+		for _, p := range peers {
+			if host.Network().Connectedness(p.ID) == net.Connected {
+				continue
+			}
+			tctx, cancel := context.WithTimeout(ctx, s.timeout)
+			err := host.Connect(tctx, p)
+			cancel()
+			if err != nil {
+				ui.Verbose("Unable to reconnect to %s: %v", p.ID.Pretty(), err)
+				continue
+			}
+			ui.Verbose("Reconnected to %s", p.ID.Pretty())
+			s.markConnected()
+		}
 	}
+}
 
-	genesisPath, err := iface.ParsePath(path.Join("/ipfs", chainID, "genesis.json"))
-	if err != nil {
-		return nil, err
+// AddPeer connects directly to the peer at addr, which must include the peer
+// ID ("/ip4/1.2.3.4/tcp/4001/ipfs/<id>"). The peer is then used to fetch
+// network content and is always asked for node information by Peers and
+// WatchPeers, without going through the DHT.
+func (s *Server) AddPeer(ctx context.Context, addr string) error {
+	if s.offline {
+		return ErrOffline
 	}
-	genesisFile, err := s.api.Unixfs().Get(ctx, genesisPath)
+	peerinfo, err := parsePeer(addr)
 	if err != nil {
-		return nil, err
+		return errors.Wrapf(err, "invalid peer %q", addr)
 	}
-	genesisData, err := ioutil.ReadAll(genesisFile)
+	err = s.bootstrapConnect(ctx, peerinfo)
+	s.metrics.Bootstrap(err)
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to read genesis file")
+		return errors.Wrapf(err, "unable to connect to peer %q", addr)
 	}
 
-	imagePath, err := iface.ParsePath(path.Join("/ipfs", chainID, "image.tgz"))
-	imageFile, err := s.api.Unixfs().Get(ctx, imagePath)
-	if err != nil {
-		return nil, err
+	s.node.PeerHost.ConnManager().TagPeer(peerinfo.ID, peerTag, 100)
+
+	s.peersMu.Lock()
+	s.peers = append(s.peers, *peerinfo)
+	s.peersMu.Unlock()
+
+	s.markConnected()
+	return nil
+}
+
+// markConnected records a successful connection and unblocks waitConnected.
+func (s *Server) markConnected() {
+	atomic.AddInt32(&s.connected, 1)
+	s.readyOnce.Do(func() { close(s.connectedCh) })
+}
+
+// ID returns the peer ID of the discovery node. Only valid after Start.
+func (s *Server) ID() string {
+	return s.node.Identity.Pretty()
+}
+
+// Addrs returns the full multiaddrs (including the peer ID) other nodes can
+// use to reach the discovery node. Only valid after Start.
+func (s *Server) Addrs() []string {
+	return s.AnnounceAddresses()
+}
+
+// ListenAddresses returns the swarm listen multiaddrs. Only valid after Start.
+func (s *Server) ListenAddresses() []string {
+	if s.offline {
+		return []string{}
+	}
+	addrs := s.node.PeerHost.Network().ListenAddresses()
+	out := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		out = append(out, addr.String())
+	}
+	return out
+}
+
+// AnnounceAddresses returns the addresses announced to other peers, suffixed
+// with the peer ID. Only valid after Start.
+func (s *Server) AnnounceAddresses() []string {
+	if s.offline {
+		return []string{}
+	}
+	return s.p2pAddrs(s.node.PeerHost.Addrs())
+}
+
+// p2pAddrs appends the peer ID to every host address.
+func (s *Server) p2pAddrs(addrs []multiaddr.Multiaddr) []string {
+	id := s.ID()
+	out := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		out = append(out, path.Join(addr.String(), "ipfs", id))
+	}
+	return out
+}
+
+// ConnectedPeers returns the number of successful connections to bootstrap
+// and explicit peers, including the reconnections and the local peers found
+// through mDNS.
+func (s *Server) ConnectedPeers() int {
+	return int(atomic.LoadInt32(&s.connected))
+}
+
+// waitConnected blocks until the DHT is connected to at least one bootstrap
+// peer, or returns an error if none could be reached or the server is
+// offline.
+func (s *Server) waitConnected(ctx context.Context) error {
+	if s.offline {
+		return ErrOffline
+	}
+	select {
+	case <-s.connectedCh:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	// A private swarm node without bootstrap peers is the first member.
+	if s.ConnectedPeers() == 0 && len(s.bootstrapPeers) > 0 {
+		return ErrNotConnected
+	}
+	return nil
+}
+
+// bootstrapConnect connects to a single bootstrap peer, retrying with an
+// exponential backoff.
+func (s *Server) bootstrapConnect(ctx context.Context, peerinfo *pstore.PeerInfo) error {
+	var (
+		delay = s.retryDelay
+		err   error
+	)
+	for attempt := 1; ; attempt++ {
+		if err = s.node.PeerHost.Connect(ctx, *peerinfo); err == nil {
+			return nil
+		}
+		if attempt >= s.maxAttempts {
+			return err
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		delay *= 2
+	}
+}
+
+// Publish publishes chain information. Returns the chain ID.
+func (s *Server) Publish(ctx context.Context, manifestPath, genesisPath, imagePath string) (string, error) {
+	sources := []struct {
+		name, path string
+	}{
+		{"manifest", manifestPath},
+		{"genesis", genesisPath},
+		{"image", imagePath},
+	}
+	for _, src := range sources {
+		fi, err := os.Stat(src.path)
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("%s file %q not found", src.name, src.path)
+		}
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to access %s file", src.name)
+		}
+		if fi.IsDir() {
+			return "", fmt.Errorf("%s file %q is a directory", src.name, src.path)
+		}
+	}
+
+	sandbox, err := ioutil.TempDir(os.TempDir(), "chainkit-network")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(sandbox)
+
+	st, err := os.Stat(sandbox)
+	if err != nil {
+		return "", err
+	}
+
+	if err := linkOrCopy(manifestPath, path.Join(sandbox, "chainkit.yml")); err != nil {
+		return "", err
+	}
+	if err := linkOrCopy(genesisPath, path.Join(sandbox, "genesis.json")); err != nil {
+		return "", err
+	}
+	if err := s.addImage(imagePath, path.Join(sandbox, "image.tgz")); err != nil {
+		return "", err
+	}
+
+	// The sandbox is removed once the files have been added, whether they
+	// were linked or copied into it. Close any handle left open by the add
+	// first.
+	f, err := files.NewSerialFile("network", sandbox, false, st)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	return s.add(ctx, f)
+}
+
+// PublishReaders is like Publish, but streams the network files from readers
+// rather than from disk. Returns the chain ID.
+func (s *Server) PublishReaders(ctx context.Context, manifest, genesis, image io.Reader) (string, error) {
+	compressed := s.compressImage(image)
+	defer compressed.Close()
+
+	readerFile := func(name string, r io.Reader) files.File {
+		p := path.Join("network", name)
+		return files.NewReaderFile(p, p, ioutil.NopCloser(r), nil)
+	}
+	f := files.NewSliceFile("network", "network", []files.File{
+		readerFile("chainkit.yml", manifest),
+		readerFile("genesis.json", genesis),
+		readerFile("image.tgz", compressed),
+	})
+
+	return s.add(ctx, f)
+}
+
+// add adds the network directory to IPFS and pins it. Returns the chain ID.
+func (s *Server) add(ctx context.Context, f files.File) (string, error) {
+	p, err := s.api.Unixfs().Add(ctx, f)
+	if err != nil {
+		return "", err
+	}
+
+	// Pin the network recursively so it doesn't get garbage collected.
+	if err := s.api.Pin().Add(ctx, p); err != nil {
+		return "", errors.Wrap(err, "unable to pin network")
+	}
+
+	chainID := p.Cid().String()
+	if err := s.recordNetwork(chainID, ""); err != nil {
+		ui.Warn("Unable to record network %s: %v", chainID, err)
+	}
+
+	return chainID, nil
+}
+
+// compressImage returns a gzipped stream of image, compressing it on the fly
+// if it isn't already.
+func (s *Server) compressImage(image io.Reader) io.ReadCloser {
+	br := bufio.NewReader(image)
+	if magic, err := br.Peek(2); err == nil && isGzip(magic) {
+		return ioutil.NopCloser(br)
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		gz, err := gzip.NewWriterLevel(pw, s.compressionLevel)
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		if _, err := io.Copy(gz, br); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		pw.CloseWithError(gz.Close())
+	}()
+	return pr
+}
+
+// addImage places the image at dst, compressing it if it isn't already.
+func (s *Server) addImage(src, dst string) error {
+	compressed, err := isGzipFile(src)
+	if err != nil {
+		return err
+	}
+	if compressed {
+		return linkOrCopy(src, dst)
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	gz, err := gzip.NewWriterLevel(out, s.compressionLevel)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(gz, in); err != nil {
+		return errors.Wrap(err, "unable to compress image")
+	}
+	return gz.Close()
+}
+
+// linkOrCopy hard links src to dst, falling back to a copy if they are on
+// different devices.
+func linkOrCopy(src, dst string) error {
+	err := os.Link(src, dst)
+	if linkErr, ok := err.(*os.LinkError); ok && linkErr.Err == syscall.EXDEV {
+		return copyFile(src, dst)
+	}
+	return err
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	if _, err = io.Copy(out, in); err != nil {
+		return err
+	}
+	return out.Sync()
+}
+
+// isGzipFile returns true if the file starts with the gzip magic bytes.
+func isGzipFile(p string) (bool, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	magic := make([]byte, 2)
+	if _, err := io.ReadFull(f, magic); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return false, nil
+		}
+		return false, err
+	}
+	return isGzip(magic), nil
+}
+
+func isGzip(magic []byte) bool {
+	return len(magic) >= 2 && magic[0] == 0x1f && magic[1] == 0x8b
+}
+
+// Republish publishes updated chain information for an existing network.
+// The new content gets a new CID, but it is also published under an IPNS
+// name derived from the original chainID: that name stays stable across
+// republishes, and joining "/ipns/<name>" always fetches the latest files.
+// Returns the IPNS name.
+func (s *Server) Republish(ctx context.Context, chainID, manifestPath, genesisPath, imagePath string) (string, error) {
+	if _, err := cid.Decode(chainID); err != nil {
+		return "", errors.Wrapf(err, "invalid chain ID %q", chainID)
+	}
+
+	newID, err := s.Publish(ctx, manifestPath, genesisPath, imagePath)
+	if err != nil {
+		return "", err
+	}
+	c, err := cid.Decode(newID)
+	if err != nil {
+		return "", err
+	}
+
+	keyName, err := s.networkKey(ctx, chainID)
+	if err != nil {
+		return "", err
+	}
+	entry, err := s.api.Name().Publish(ctx, iface.IpfsPath(c), options.Name.Key(keyName))
+	if err != nil {
+		return "", errors.Wrap(err, "unable to publish IPNS record")
+	}
+
+	return entry.Name(), nil
+}
+
+// networkKey returns the name of the IPNS key associated with chainID,
+// generating it if needed.
+func (s *Server) networkKey(ctx context.Context, chainID string) (string, error) {
+	name := "chainkit-" + chainID
+
+	keys, err := s.api.Key().List(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, k := range keys {
+		if k.Name() == name {
+			return name, nil
+		}
+	}
+
+	if _, err := s.api.Key().Generate(ctx, name); err != nil {
+		return "", errors.Wrap(err, "unable to generate network key")
+	}
+	return name, nil
+}
+
+// resolveName resolves an IPNS name ("/ipns/...") to a chain ID.
+func (s *Server) resolveName(ctx context.Context, name string) (string, error) {
+	p, err := s.api.Name().Resolve(ctx, name)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to resolve %q", name)
+	}
+	rp, err := s.api.ResolvePath(ctx, p)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to resolve %q", name)
+	}
+	return rp.Cid().String(), nil
+}
+
+// Pin keeps the content of a joined network in the local repository, so that
+// it survives Prune while the node provides it. Pin once the network files
+// returned by Join have been read: the content is then available locally and
+// only gets pinned if the network could be joined.
+func (s *Server) Pin(ctx context.Context, chainID string) error {
+	root, err := iface.ParsePath(path.Join("/ipfs", chainID))
+	if err != nil {
+		return err
+	}
+	if err := s.api.Pin().Add(ctx, root); err != nil {
+		return errors.Wrap(err, "unable to pin network")
+	}
+	return nil
+}
+
+// Unpin releases a previously published or joined network, allowing its content to be
+// garbage collected. It is a no-op if the network isn't pinned.
+func (s *Server) Unpin(ctx context.Context, chainID string) error {
+	id, err := cid.Decode(chainID)
+	if err != nil {
+		return err
+	}
+
+	pins, err := s.api.Pin().Ls(ctx, options.Pin.Type.Recursive())
+	if err != nil {
+		return errors.Wrap(err, "unable to list pins")
+	}
+	pinned := false
+	for _, pin := range pins {
+		if pin.Path().Cid().Equals(id) {
+			pinned = true
+			break
+		}
+	}
+	if !pinned {
+		return nil
+	}
+
+	if err := s.api.Pin().Rm(ctx, iface.IpfsPath(id)); err != nil {
+		return errors.Wrap(err, "unable to unpin network")
+	}
+	return nil
+}
+
+// PruneResult is the outcome of Prune.
+type PruneResult struct {
+	// Blocks is the number of blocks removed.
+	Blocks int
+	// Reclaimed is the number of bytes freed in the repository.
+	Reclaimed uint64
+}
+
+// Prune garbage collects the blocks which aren't pinned, such as the content
+// of the networks left with Unpin. The published and joined networks are
+// pinned, and kept.
+func (s *Server) Prune(ctx context.Context) (*PruneResult, error) {
+	before, err := corerepo.RepoSize(ctx, s.node)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to compute the repository size")
+	}
+
+	roots, err := corerepo.BestEffortRoots(s.node.FilesRoot)
+	if err != nil {
+		return nil, err
+	}
+	res := &PruneResult{}
+	removed := gc.GC(ctx, s.node.Blockstore, s.node.Repo.Datastore(), s.node.Pinning, roots)
+	err = corerepo.CollectResult(ctx, removed, func(cid.Cid) {
+		res.Blocks++
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to collect garbage")
+	}
+
+	after, err := corerepo.RepoSize(ctx, s.node)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to compute the repository size")
+	}
+	if after.RepoSize < before.RepoSize {
+		res.Reclaimed = before.RepoSize - after.RepoSize
+	}
+	return res, nil
+}
+
+// Join joins a network. chainID may also be an alias registered with
+// PublishAlias, or an IPNS name ("/ipns/...") returned by Republish. The
+// network content isn't pinned: see Pin.
+func (s *Server) Join(ctx context.Context, chainID string) (*NetworkInfo, error) {
+	defer func(start time.Time) {
+		s.metrics.Join(time.Since(start))
+	}(time.Now())
+
+	name := chainID
+	chainID, err := s.ResolveAlias(name)
+	if err != nil {
+		return nil, err
+	}
+	alias := ""
+	if chainID != name {
+		alias = name
+	}
+	if strings.HasPrefix(chainID, "/ipns/") {
+		chainID, err = s.resolveName(ctx, chainID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	manifestPath, err := iface.ParsePath(path.Join("/ipfs", chainID, "chainkit.yml"))
+	if err != nil {
+		return nil, err
+	}
+	manifestFile, err := s.api.Unixfs().Get(ctx, manifestPath)
+	if err != nil {
+		return nil, s.localError(chainID, err)
+	}
+	defer manifestFile.Close()
+	manifestData, err := ioutil.ReadAll(manifestFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read manifest file")
+	}
+
+	genesisPath, err := iface.ParsePath(path.Join("/ipfs", chainID, "genesis.json"))
+	if err != nil {
+		return nil, err
+	}
+	genesisFile, err := s.api.Unixfs().Get(ctx, genesisPath)
+	if err != nil {
+		return nil, s.localError(chainID, err)
+	}
+	defer genesisFile.Close()
+	genesisData, err := ioutil.ReadAll(genesisFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read genesis file")
+	}
+
+	imagePath, err := iface.ParsePath(path.Join("/ipfs", chainID, "image.tgz"))
+	if err != nil {
+		return nil, err
+	}
+	imageFile, err := s.api.Unixfs().Get(ctx, imagePath)
+	if err != nil {
+		return nil, s.localError(chainID, err)
+	}
+
+	p, err := project.Parse(bytes.NewReader(manifestData))
+	if err != nil {
+		imageFile.Close()
+		return nil, errors.Wrap(err, "corrupt network file chainkit.yml")
+	}
+	if !json.Valid(genesisData) {
+		imageFile.Close()
+		return nil, errors.New("corrupt network file genesis.json: invalid JSON")
+	}
+	var size int64
+	if sf, ok := imageFile.(files.SizeFile); ok {
+		if size, err = sf.Size(); err != nil {
+			size = 0
+		}
+	}
+	image, err := verifyImage(&progressReader{
+		ReadCloser: imageFile,
+		total:      size,
+		label:      "Downloading image",
+	})
+	if err != nil {
+		imageFile.Close()
+		return nil, errors.Wrap(err, "corrupt network file image.tgz")
+	}
+
+	// Inspecting a network offline doesn't count as joining it.
+	if !s.offline {
+		if err := s.recordNetwork(chainID, alias); err != nil {
+			ui.Warn("Unable to record network %s: %v", chainID, err)
+		}
 	}
 
 	return &NetworkInfo{
+		ChainID:  chainID,
 		Manifest: manifestData,
 		Genesis:  genesisData,
-		Image:    imageFile,
+		Image:    image,
+		project:  p,
 	}, nil
 
 	// return manifestFile, genesisFile, imageFile, nil
 }
 
+// localError explains that err is caused by missing local content when
+// running offline.
+func (s *Server) localError(chainID string, err error) error {
+	if !s.offline {
+		return err
+	}
+	return errors.Wrapf(err, "network %s is not available locally", chainID)
+}
+
+// verifyImage makes sure the image is a valid (optionally gzipped) tarball.
+// It returns a reader streaming the whole, decompressed, image.
+func verifyImage(r io.ReadCloser) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+
+	var img io.Reader = br
+	if magic, err := br.Peek(2); err == nil && isGzip(magic) {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		img = gz
+	}
+
+	var consumed bytes.Buffer
+	if _, err := tar.NewReader(io.TeeReader(img, &consumed)).Next(); err != nil {
+		return nil, errors.Wrap(err, "invalid tar header")
+	}
+
+	return &readCloser{
+		Reader: io.MultiReader(&consumed, img),
+		Closer: r,
+	}, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// progressInterval is how often download progress gets reported.
+const progressInterval = 100 * time.Millisecond
+
+// progressReader reports the progress of the underlying reader as it gets read.
+type progressReader struct {
+	io.ReadCloser
+
+	read     int64
+	total    int64
+	label    string
+	reported time.Time
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.read += int64(n)
+	if err == io.EOF || time.Since(r.reported) >= progressInterval {
+		ui.Progress(r.read, r.total, r.label)
+		r.reported = time.Now()
+	}
+	return n, err
+}
+
+// Observing returns true if the server doesn't announce itself.
+func (s *Server) Observing() bool {
+	return s.observe
+}
+
 // Announce announces our presence as a network node.
+// It does nothing in observe mode.
 func (s *Server) Announce(ctx context.Context, chainID string, peer *PeerInfo) error {
+	if s.observe {
+		return nil
+	}
+	if peer.TendermintP2PPort == 0 {
+		return errors.New("peer has no Tendermint P2P port")
+	}
+
 	// Wait for the DHT to be connected before searching.
-	<-s.connectedCh
+	if err := s.waitConnected(ctx); err != nil {
+		return err
+	}
 
 	id, err := cid.Decode(chainID)
 	if err != nil {
 		return err
 	}
 
-	s.node.PeerHost.SetStreamHandler("/chainkit/0.1.0", func(stream net.Stream) {
-		defer stream.Close()
-		enc := json.NewEncoder(stream)
-		if err := enc.Encode(peer); err != nil {
-			ui.Error("failed to encode: %v", err)
-			return
+	s.announcedMu.Lock()
+	if s.announced == nil {
+		s.announced = make(map[string]*PeerInfo)
+	}
+	s.announced[id.String()] = peer
+	s.announcedMu.Unlock()
+
+	s.handlersOnce.Do(func() {
+		for _, version := range protocolVersions {
+			s.node.PeerHost.SetStreamHandler(protocolID(s.namespace, version), s.streamHandler(version))
 		}
 	})
 
-	cctx, cancel := context.WithTimeout(ctx, 10*time.Second)
-	defer cancel()
-	if err := s.dht.Provide(cctx, id, true); err != nil {
+	return s.provide(ctx, id)
+}
+
+// AnnounceNetworks announces this node on several networks at once, mapping
+// every chain ID to the node information to serve for it. It returns once
+// all the announcements are done, with the first error encountered.
+func (s *Server) AnnounceNetworks(ctx context.Context, peers map[string]*PeerInfo) error {
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for chainID, peer := range peers {
+		wg.Add(1)
+		go func(chainID string, peer *PeerInfo) {
+			defer wg.Done()
+			if err := s.Announce(ctx, chainID, peer); err != nil {
+				errOnce.Do(func() {
+					firstErr = errors.Wrapf(err, "unable to announce %s", chainID)
+				})
+			}
+		}(chainID, peer)
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// streamHandler returns the handler serving the node information for the
+// given protocol version.
+func (s *Server) streamHandler(version string) func(net.Stream) {
+	return func(stream net.Stream) {
+		s.serveStream(stream, version)
+	}
+}
+
+// peerStream is the part of a stream used to serve peers.
+type peerStream interface {
+	io.ReadWriteCloser
+	SetDeadline(time.Time) error
+}
+
+// serveStream answers the node information request of a peer.
+func (s *Server) serveStream(stream peerStream, version string) {
+	defer stream.Close()
+	if !s.trackStream() {
+		return
+	}
+	defer s.streams.Done()
+
+	stream.SetDeadline(time.Now().Add(s.timeout))
+	chainID, err := decodeRequest(stream, version)
+	if err != nil {
+		s.metrics.StreamError("decode", err)
+		ui.Error("failed to decode request: %v", err)
+		return
+	}
+
+	peer := s.announcedPeer(chainID)
+	if peer == nil && chainID == "" {
+		// Protocols before protocolV3 don't name the chain: with several
+		// chains announced, answering with any of them could register us
+		// for the wrong network.
+		ui.Verbose("Rejecting a %s request, which doesn't name a network", protocolID(s.namespace, version))
+		if err := encodeError(stream, version, errNoChainRequested); err != nil {
+			s.metrics.StreamError("encode", err)
+		}
+		return
+	}
+
+	if err := encodePeer(stream, version, peer); err != nil {
+		s.metrics.StreamError("encode", err)
+		ui.Error("failed to encode: %v", err)
+		return
+	}
+}
+
+// announcedPeer returns the node information announced for chainID. An empty
+// chainID stands for the announced chain if there's only one. Returns nil if
+// the chain wasn't announced.
+func (s *Server) announcedPeer(chainID string) *PeerInfo {
+	s.announcedMu.Lock()
+	defer s.announcedMu.Unlock()
+	if chainID == "" && len(s.announced) == 1 {
+		for _, peer := range s.announced {
+			return peer
+		}
+	}
+	return s.announced[chainID]
+}
+
+// Provide announces that we provide the network content, without registering
+// as a network node.
+func (s *Server) Provide(ctx context.Context, chainID string) error {
+	if err := s.waitConnected(ctx); err != nil {
 		return err
 	}
-	return nil
+
+	id, err := cid.Decode(chainID)
+	if err != nil {
+		return err
+	}
+
+	return s.provide(ctx, id)
+}
+
+func (s *Server) provide(ctx context.Context, id cid.Cid) error {
+	// Without DHT, peers ask us directly.
+	if s.dht == nil {
+		return nil
+	}
+	cctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	start := time.Now()
+	err := s.dht.Provide(cctx, s.providerKey(id), true)
+	s.metrics.Provide(time.Since(start), err)
+	return err
+}
+
+// providerKey returns the DHT key under which the nodes of the network id
+// are provided. In the global namespace, this is the network content itself.
+// Otherwise, the key is derived from the namespace so that the records of
+// other namespaces, and the plain IPFS providers of the content, don't show
+// up in lookups.
+func (s *Server) providerKey(id cid.Cid) cid.Cid {
+	if s.namespace == "" {
+		return id
+	}
+	h, err := mh.Sum([]byte(path.Join("/chainkit", s.namespace, id.String())), mh.SHA2_256, -1)
+	if err != nil {
+		// SHA2-256 is always available.
+		panic(err)
+	}
+	return cid.NewCidV1(cid.Raw, h)
 }
 
 // Peers looks for peers in the network
 func (s *Server) Peers(ctx context.Context, chainID string) (<-chan *PeerInfo, error) {
 	// Wait for the DHT to be connected before searching.
-	<-s.connectedCh
+	if err := s.waitConnected(ctx); err != nil {
+		return nil, err
+	}
 
 	id, err := cid.Decode(chainID)
 	if err != nil {
@@ -294,41 +1794,259 @@ func (s *Server) Peers(ctx context.Context, chainID string) (<-chan *PeerInfo, e
 
 	ch := make(chan *PeerInfo)
 	go func() {
-		tctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer close(ch)
+		s.findPeers(ctx, id, make(map[string]struct{}), ch)
+	}()
+
+	return ch, nil
+}
+
+// HasProviders returns true if at least one node currently provides chainID.
+func (s *Server) HasProviders(ctx context.Context, chainID string) (bool, error) {
+	if err := s.waitConnected(ctx); err != nil {
+		return false, err
+	}
+
+	id, err := cid.Decode(chainID)
+	if err != nil {
+		return false, err
+	}
 
-		defer cancel()
+	tctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	// Without DHT, ask the peers we know about.
+	if s.dht == nil {
+		ch := make(chan *PeerInfo)
+		go func() {
+			defer close(ch)
+			s.findPeers(tctx, id, make(map[string]struct{}), ch)
+		}()
+		_, ok := <-ch
+		cancel()
+		for range ch {
+		}
+		return ok, nil
+	}
+
+	for p := range s.dht.FindProvidersAsync(tctx, s.providerKey(id), 10) {
+		if p.ID != s.node.PeerHost.ID() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// WatchPeers is like Peers, but keeps querying the network for new peers
+// until the context is cancelled. Every peer is emitted only once.
+func (s *Server) WatchPeers(ctx context.Context, chainID string) (<-chan *PeerInfo, error) {
+	// Wait for the DHT to be connected before searching.
+	if err := s.waitConnected(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := cid.Decode(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *PeerInfo)
+	go func() {
 		defer close(ch)
 
-		peers := s.dht.FindProvidersAsync(tctx, id, 10)
-		for p := range peers {
-			if p.ID != s.node.PeerHost.ID() && len(p.Addrs) > 0 {
-				stream, err := s.node.PeerHost.NewStream(ctx, p.ID, "/chainkit/0.1.0")
-				if err != nil {
+		seen := make(map[string]struct{})
+		for {
+			s.findPeers(ctx, id, seen, ch)
+
+			select {
+			case <-time.After(watchPeersInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// findPeers runs a single provider lookup and sends the peers not already in
+// seen to ch. Providers are queried concurrently.
+func (s *Server) findPeers(ctx context.Context, id cid.Cid, seen map[string]struct{}, ch chan<- *PeerInfo) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	found := 0
+	defer func(start time.Time) {
+		s.metrics.Lookup(time.Since(start), found)
+	}(time.Now())
+
+	// Feed the explicit peers, then the providers found in the DHT.
+	candidates := make(chan pstore.PeerInfo)
+	go func() {
+		defer close(candidates)
+
+		s.peersMu.Lock()
+		explicit := append([]pstore.PeerInfo(nil), s.peers...)
+		s.peersMu.Unlock()
+
+		send := func(p pstore.PeerInfo) bool {
+			select {
+			case candidates <- p:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		for _, p := range explicit {
+			if !send(p) {
+				return
+			}
+		}
+
+		// Without DHT, every connected peer (bootstrap peers, local peers
+		// found with mDNS) is a candidate.
+		if s.dht == nil {
+			known := make(map[string]struct{})
+			for _, p := range explicit {
+				known[p.ID.Pretty()] = struct{}{}
+			}
+			host := s.node.PeerHost
+			for _, pid := range host.Network().Peers() {
+				if _, ok := known[pid.Pretty()]; ok {
 					continue
 				}
-				dec := json.NewDecoder(stream)
-				peer := &PeerInfo{}
-				if err := dec.Decode(peer); err != nil {
-					ui.Error("failed to decode: %v", err)
-					continue
+				if !send(host.Peerstore().PeerInfo(pid)) {
+					return
 				}
+			}
+			return
+		}
 
-				if peer.IP == nil {
-					peer.IP = []string{}
-				}
-				for _, addr := range p.Addrs {
-					v, err := addr.ValueForProtocol(multiaddr.P_IP4)
-					if err != nil || v == "" {
-						continue
-					}
+		tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+		defer tcancel()
+		providers := s.dht.FindProvidersAsync(tctx, s.providerKey(id), s.maxProviders)
+		for p := range providers {
+			if p.ID == s.node.PeerHost.ID() || len(p.Addrs) == 0 {
+				continue
+			}
+			if !send(p) {
+				return
+			}
+		}
+	}()
 
-					peer.IP = append(peer.IP, v)
+	results := make(chan *PeerInfo)
+	var wg sync.WaitGroup
+	for i := 0; i < s.queryConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range candidates {
+				peer, err := s.queryPeer(ctx, id.String(), p)
+				if err != nil {
+					continue
 				}
+				select {
+				case results <- peer:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
 
-				ch <- peer
+	for {
+		var peer *PeerInfo
+		select {
+		case p, ok := <-results:
+			if !ok {
+				return
 			}
+			peer = p
+		case <-ctx.Done():
+			return
+		}
+		if _, ok := seen[peer.NodeID]; ok {
+			continue
+		}
+		select {
+		case ch <- peer:
+			seen[peer.NodeID] = struct{}{}
+			found++
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// queryPeer asks p for its node information on the chainID network.
+func (s *Server) queryPeer(ctx context.Context, chainID string, p pstore.PeerInfo) (*PeerInfo, error) {
+	// Protocols are negotiated newest to oldest.
+	stream, err := s.node.PeerHost.NewStream(ctx, p.ID, protocolIDs(s.namespace)...)
+	if err != nil {
+		return nil, err
+	}
+	// Don't let an unresponsive peer block the lookup: reads don't honor
+	// the context, so reset the stream once it's done.
+	stream.SetDeadline(time.Now().Add(s.timeout))
+	queryDone := make(chan struct{})
+	defer close(queryDone)
+	go func() {
+		select {
+		case <-ctx.Done():
+			stream.Reset()
+		case <-queryDone:
 		}
 	}()
+	version := protocolVersion(stream.Protocol())
+	if err := encodeRequest(stream, version, chainID); err != nil {
+		stream.Close()
+		return nil, err
+	}
+	peer, err := decodePeer(stream, version)
+	stream.Close()
+	if err != nil {
+		// Peers not serving the chain answer with an error.
+		if _, ok := err.(remoteError); !ok {
+			s.metrics.StreamError("decode", err)
+		}
+		ui.Verbose("Peer %s: %v", p.ID.Pretty(), err)
+		return nil, err
+	}
 
-	return ch, nil
+	peer.IP = mergeIPs(peer.IP, p.Addrs)
+	return peer, nil
+}
+
+// mergeIPs appends the IPv4 and IPv6 addresses found in addrs to ips,
+// skipping duplicates.
+func mergeIPs(ips []string, addrs []multiaddr.Multiaddr) []string {
+	merged := []string{}
+	seen := make(map[string]struct{})
+	add := func(ip string) {
+		if _, ok := seen[ip]; ok || ip == "" {
+			return
+		}
+		seen[ip] = struct{}{}
+		merged = append(merged, ip)
+	}
+
+	for _, ip := range ips {
+		add(ip)
+	}
+	for _, addr := range addrs {
+		for _, proto := range []int{multiaddr.P_IP4, multiaddr.P_IP6} {
+			v, err := addr.ValueForProtocol(proto)
+			if err != nil {
+				continue
+			}
+			add(v)
+		}
+	}
+
+	return merged
 }
@@ -0,0 +1,353 @@
+package discovery
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	stdnet "net"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	net "github.com/ipsn/go-ipfs/gxlibs/github.com/libp2p/go-libp2p-net"
+)
+
+// testChainID is a valid chain ID, the CID of an empty directory.
+const testChainID = "QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn"
+
+// newTestServer returns a server which isn't started, for the tests not
+// needing IPFS.
+func newTestServer(t *testing.T) *Server {
+	root, err := ioutil.TempDir("", "discovery-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(root) })
+
+	s, err := New(root, 0, Options{Routing: RoutingNone})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// startTestServer returns a started server connected to no other peer.
+func startTestServer(t *testing.T, opts Options) *Server {
+	if testing.Short() {
+		t.Skip("starting an IPFS node is slow")
+	}
+	root, err := ioutil.TempDir("", "discovery-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(root) })
+
+	opts.Routing = RoutingNone
+	opts.KeyType = KeyTypeEd25519
+	s, err := New(root, 0, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Stop() })
+	return s
+}
+
+// localAddr returns the loopback address of s.
+func localAddr(t *testing.T, s *Server) string {
+	for _, addr := range s.Addrs() {
+		if strings.HasPrefix(addr, "/ip4/127.0.0.1/") {
+			return addr
+		}
+	}
+	t.Fatalf("no loopback address in %v", s.Addrs())
+	return ""
+}
+
+func TestPeersCancel(t *testing.T) {
+	// The remote peer never answers: the lookup only ends with the
+	// timeout, or once cancelled.
+	remote := startTestServer(t, Options{})
+	hang := make(chan struct{})
+	defer close(hang)
+	for _, id := range protocolIDs("") {
+		remote.node.PeerHost.SetStreamHandler(id, func(stream net.Stream) {
+			<-hang
+			stream.Close()
+		})
+	}
+
+	s := startTestServer(t, Options{Timeout: time.Minute})
+	if err := s.AddPeer(context.Background(), localAddr(t, remote)); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := s.Peers(ctx, testChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Give the lookup time to query the remote peer.
+	time.Sleep(200 * time.Millisecond)
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range ch {
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("lookup still running after cancellation")
+	}
+}
+
+func TestStopDuringRead(t *testing.T) {
+	s := newTestServer(t)
+
+	var req bytes.Buffer
+	if err := encodeRequest(&req, protocolV3, "chain"); err != nil {
+		t.Fatal(err)
+	}
+
+	client, server := stdnet.Pipe()
+	defer client.Close()
+	served := make(chan struct{})
+	go func() {
+		defer close(served)
+		s.serveStream(server, protocolV3)
+	}()
+
+	// Pipe writes only return once read: the handler is now in the
+	// middle of reading the request.
+	if _, err := client.Write(req.Bytes()[:1]); err != nil {
+		t.Fatal(err)
+	}
+
+	drained := make(chan bool)
+	go func() {
+		drained <- s.drainStreams(5 * time.Second)
+	}()
+	select {
+	case <-drained:
+		t.Fatal("drained while a stream was being served")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// New streams are rejected once stopping.
+	lateClient, lateServer := stdnet.Pipe()
+	defer lateClient.Close()
+	s.serveStream(lateServer, protocolV3)
+	if _, err := lateClient.Write(req.Bytes()); err == nil {
+		t.Fatal("stream accepted while stopping")
+	}
+
+	// Let the in-flight stream complete.
+	if _, err := client.Write(req.Bytes()[1:]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := decodePeer(client, protocolV3); err == nil {
+		t.Fatal("expected an error for a chain which isn't served")
+	}
+	<-served
+
+	if !<-drained {
+		t.Fatal("streams not drained")
+	}
+}
+
+func TestPublishRemovesSandbox(t *testing.T) {
+	s := startTestServer(t, Options{Offline: true})
+
+	src, err := ioutil.TempDir("", "discovery-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(src)
+
+	// Sandboxes are created in the temporary directory.
+	tmp, err := ioutil.TempDir("", "discovery-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	t.Setenv("TMPDIR", tmp)
+
+	files := map[string]string{
+		"chainkit.yml": "name: test\n",
+		"genesis.json": "{}",
+		"image.tar":    "image",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(path.Join(src, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	publish := func(ctx context.Context) error {
+		_, err := s.Publish(ctx, path.Join(src, "chainkit.yml"), path.Join(src, "genesis.json"), path.Join(src, "image.tar"))
+		return err
+	}
+	checkSandbox := func() {
+		entries, err := ioutil.ReadDir(tmp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, e := range entries {
+			t.Errorf("sandbox %s left behind", e.Name())
+		}
+	}
+
+	if err := publish(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	checkSandbox()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := publish(ctx); err == nil {
+		t.Fatal("expected publishing to fail once cancelled")
+	}
+	checkSandbox()
+}
+
+// request sends a request for chainID to s using the given protocol version
+// and returns the answer.
+func request(t *testing.T, s *Server, version, chainID string) (*PeerInfo, error) {
+	client, server := stdnet.Pipe()
+	defer client.Close()
+	go s.serveStream(server, version)
+
+	if err := encodeRequest(client, version, chainID); err != nil {
+		t.Fatal(err)
+	}
+	return decodePeer(client, version)
+}
+
+func TestServeStream(t *testing.T) {
+	s := newTestServer(t)
+	s.announced = map[string]*PeerInfo{
+		testChainID: testPeer(),
+	}
+
+	peer, err := request(t, s, protocolV3, testChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if peer.NodeID != testPeer().NodeID {
+		t.Fatalf("got node %q, want %q", peer.NodeID, testPeer().NodeID)
+	}
+
+	if _, err := request(t, s, protocolV3, "other"); err != errNotServed {
+		t.Fatalf("unexpected error for a chain not served: %v", err)
+	}
+
+	// Requests without chain get the only chain announced.
+	for _, version := range []string{protocolV1, protocolV2, protocolV3} {
+		peer, err := request(t, s, version, "")
+		if err != nil {
+			t.Fatalf("unexpected error for a %s request without chain: %v", version, err)
+		}
+		if peer.NodeID != testPeer().NodeID {
+			t.Fatalf("got node %q for a %s request, want %q", peer.NodeID, version, testPeer().NodeID)
+		}
+	}
+
+	// With several chains announced, they get an error rather than the
+	// information of any of the chains.
+	other := testPeer()
+	other.NodeID = "other"
+	s.announced["other"] = other
+	for _, version := range []string{protocolV2, protocolV3} {
+		if _, err := request(t, s, version, ""); err != errNoChainRequested {
+			t.Fatalf("unexpected error for a %s request without chain: %v", version, err)
+		}
+	}
+	// protocolV1 can't report errors: the stream gets closed.
+	if _, err := request(t, s, protocolV1, ""); err != io.EOF {
+		t.Fatalf("unexpected error for a %s request: %v", protocolV1, err)
+	}
+}
+
+// newTestServerAt returns a server listening on port, with its repository in
+// root. Servers reusing a root share their identity.
+func newTestServerAt(t *testing.T, root string, port int, opts Options) *Server {
+	opts.Routing = RoutingNone
+	opts.KeyType = KeyTypeEd25519
+	s, err := New(root, port, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestReconnectAfterStart(t *testing.T) {
+	if testing.Short() {
+		t.Skip("starting an IPFS node is slow")
+	}
+	root, err := ioutil.TempDir("", "discovery-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(root) })
+
+	l, err := stdnet.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := l.Addr().(*stdnet.TCPAddr).Port
+	l.Close()
+
+	// Start the bootstrap peer once to learn its address, then take it
+	// down so that it can't be reached on Start.
+	bootstrap := newTestServerAt(t, root, port, Options{})
+	if err := bootstrap.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	addr := localAddr(t, bootstrap)
+	bootstrap.Stop()
+
+	sroot, err := ioutil.TempDir("", "discovery-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(sroot) })
+	s := newTestServerAt(t, sroot, 0, Options{
+		BootstrapPeers:       []string{addr},
+		BootstrapMaxAttempts: 1,
+		Timeout:              time.Second,
+		ReconnectInterval:    100 * time.Millisecond,
+	})
+	if err := s.Start(context.Background()); err != ErrNotConnected {
+		t.Fatalf("Start returned %v, want ErrNotConnected", err)
+	}
+	t.Cleanup(func() { s.Stop() })
+
+	bootstrap = newTestServerAt(t, root, port, Options{})
+	if err := bootstrap.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { bootstrap.Stop() })
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		err := s.waitConnected(context.Background())
+		if err == nil {
+			break
+		}
+		if err != ErrNotConnected {
+			t.Fatal(err)
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("still not connected once the bootstrap peer is reachable")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
@@ -0,0 +1,90 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	iface "github.com/ipsn/go-ipfs/core/coreapi/interface"
+	"github.com/pkg/errors"
+)
+
+// startGateway serves the IPFS content read-only over HTTP on localhost, so
+// the published networks can be inspected without an IPFS client:
+//
+//	curl http://localhost:<port>/ipfs/<chain-id>/genesis.json
+func (s *Server) startGateway(port int) error {
+	l, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		return errors.Wrap(err, "unable to start the gateway")
+	}
+	s.gatewayAddr = "http://" + l.Addr().String()
+	s.gateway = &http.Server{
+		Handler: http.HandlerFunc(s.serveGateway),
+	}
+	go s.gateway.Serve(l)
+	return nil
+}
+
+// stopGateway shuts the gateway down, if started.
+func (s *Server) stopGateway() {
+	if s.gateway == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+	s.gateway.Shutdown(ctx)
+}
+
+// GatewayAddress returns the base URL of the HTTP gateway, or an empty
+// string if the gateway isn't enabled. Only valid after Start.
+func (s *Server) GatewayAddress() string {
+	return s.gatewayAddr
+}
+
+func (s *Server) serveGateway(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, "/ipfs/") {
+		http.NotFound(w, r)
+		return
+	}
+
+	p, err := iface.ParsePath(r.URL.Path)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), s.timeout)
+	defer cancel()
+	f, err := s.api.Unixfs().Get(ctx, p)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	defer f.Close()
+
+	// Directories are listed, one entry per line.
+	if f.IsDirectory() {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		for {
+			child, err := f.NextFile()
+			if err != nil {
+				return
+			}
+			name := child.FileName()
+			if child.IsDirectory() {
+				name += "/"
+			}
+			child.Close()
+			fmt.Fprintln(w, name)
+		}
+	}
+
+	io.Copy(w, f)
+}
@@ -0,0 +1,95 @@
+package discovery
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// indexFile is the name of the local network index within the root directory.
+const indexFile = "networks.json"
+
+// NetworkSummary describes a network known to this node.
+type NetworkSummary struct {
+	ChainID    string    `json:"chain_id"`
+	Alias      string    `json:"alias,omitempty"`
+	LastJoined time.Time `json:"last_joined"`
+}
+
+// KnownNetworks returns the networks previously joined or published,
+// most recent first.
+func (s *Server) KnownNetworks() ([]NetworkSummary, error) {
+	index, err := s.loadIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	networks := make([]NetworkSummary, 0, len(index))
+	for _, n := range index {
+		networks = append(networks, n)
+	}
+	sort.Slice(networks, func(i, j int) bool {
+		return networks[i].LastJoined.After(networks[j].LastJoined)
+	})
+	return networks, nil
+}
+
+// recordNetwork adds or refreshes a network in the index. An empty alias
+// keeps the one previously recorded.
+func (s *Server) recordNetwork(chainID, alias string) error {
+	index, err := s.loadIndex()
+	if err != nil {
+		return err
+	}
+
+	n := index[chainID]
+	n.ChainID = chainID
+	n.LastJoined = time.Now()
+	if alias != "" {
+		n.Alias = alias
+	}
+	index[chainID] = n
+
+	return s.saveIndex(index)
+}
+
+func (s *Server) indexPath() string {
+	if s.indexFile != "" {
+		return s.indexFile
+	}
+	return path.Join(s.root, indexFile)
+}
+
+func (s *Server) loadIndex() (map[string]NetworkSummary, error) {
+	index := make(map[string]NetworkSummary)
+	data, err := ioutil.ReadFile(s.indexPath())
+	if os.IsNotExist(err) {
+		return index, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read networks index")
+	}
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, errors.Wrap(err, "unable to parse networks index")
+	}
+	return index, nil
+}
+
+func (s *Server) saveIndex(index map[string]NetworkSummary) error {
+	data, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path.Dir(s.indexPath()), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(s.indexPath(), data, 0644); err != nil {
+		return errors.Wrap(err, "unable to write networks index")
+	}
+	return nil
+}
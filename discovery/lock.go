@@ -0,0 +1,87 @@
+package discovery
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"syscall"
+
+	"github.com/ipsn/go-ipfs/repo/fsrepo"
+)
+
+// RepoLocked returns true if the IPFS repository at root is in use by
+// another process, such as a running node.
+func RepoLocked(root string) (bool, error) {
+	return fsrepo.LockedByOtherProcess(root)
+}
+
+// RepoLockStale returns true if the lock of the IPFS repository at root was
+// left behind by a process which is gone, typically after a crash. A stale
+// lock keeps the repository from being opened until RemoveStaleLock is
+// called.
+func RepoLockStale(root string) (bool, error) {
+	fi, err := os.Stat(lockPath(root))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	// The lock is normally held with fcntl on an empty file, and released
+	// by the kernel when its owner exits: an empty lock file is never
+	// stale. A lock file with contents records the PID of its owner, and
+	// is only valid while that process runs.
+	if fi.Size() == 0 {
+		return false, nil
+	}
+	pid, err := lockOwner(root)
+	if err != nil {
+		// Nothing can hold a lock file with invalid contents.
+		return true, nil
+	}
+	return !processAlive(pid), nil
+}
+
+// RemoveStaleLock removes the lock of the IPFS repository at root, only if
+// it is stale. Returns false, leaving the lock in place, if a live process
+// may own it.
+func RemoveStaleLock(root string) (bool, error) {
+	stale, err := RepoLockStale(root)
+	if err != nil || !stale {
+		return false, err
+	}
+	if err := os.Remove(lockPath(root)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func lockPath(root string) string {
+	return path.Join(root, fsrepo.LockFile)
+}
+
+// lockOwner returns the PID recorded in the lock file.
+func lockOwner(root string) (int, error) {
+	f, err := os.Open(lockPath(root))
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	meta := struct {
+		OwnerPID int
+	}{}
+	if err := json.NewDecoder(f).Decode(&meta); err != nil {
+		return 0, err
+	}
+	if meta.OwnerPID <= 0 {
+		return 0, os.ErrInvalid
+	}
+	return meta.OwnerPID, nil
+}
+
+// processAlive returns true if a process with the given PID runs, even if
+// it belongs to another user.
+func processAlive(pid int) bool {
+	err := syscall.Kill(pid, 0)
+	return err == nil || err == syscall.EPERM
+}
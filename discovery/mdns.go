@@ -0,0 +1,48 @@
+package discovery
+
+import (
+	"context"
+	"time"
+
+	"github.com/blocklayerhq/chainkit/ui"
+	net "github.com/ipsn/go-ipfs/gxlibs/github.com/libp2p/go-libp2p-net"
+	pstore "github.com/ipsn/go-ipfs/gxlibs/github.com/libp2p/go-libp2p-peerstore"
+	p2pdiscovery "github.com/ipsn/go-ipfs/gxlibs/github.com/libp2p/go-libp2p/p2p/discovery"
+	"github.com/pkg/errors"
+)
+
+const (
+	mdnsServiceTag = "_chainkit-discovery._udp"
+	mdnsInterval   = 2 * time.Second
+)
+
+// startMDNS starts looking for peers on the local network. Peers are
+// connected as soon as they are found, which adds them to the DHT routing
+// table.
+func (s *Server) startMDNS(ctx context.Context) error {
+	service, err := p2pdiscovery.NewMdnsService(ctx, s.node.PeerHost, mdnsInterval, mdnsServiceTag)
+	if err != nil {
+		return errors.Wrap(err, "unable to start mDNS discovery")
+	}
+	service.RegisterNotifee(&mdnsNotifee{ctx: ctx, server: s})
+	s.mdns = service
+	return nil
+}
+
+type mdnsNotifee struct {
+	ctx    context.Context
+	server *Server
+}
+
+// HandlePeerFound is called by the mDNS service for every local peer found.
+func (n *mdnsNotifee) HandlePeerFound(pi pstore.PeerInfo) {
+	host := n.server.node.PeerHost
+	if pi.ID == host.ID() || host.Network().Connectedness(pi.ID) == net.Connected {
+		return
+	}
+	if err := host.Connect(n.ctx, pi); err != nil {
+		ui.Error("Connection with local node %s failed: %v", pi.ID.Pretty(), err)
+		return
+	}
+	n.server.markConnected()
+}
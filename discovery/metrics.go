@@ -0,0 +1,33 @@
+package discovery
+
+import "time"
+
+// Metrics receives measurements from the discovery server, for instance to
+// export them to a monitoring system. Methods are called concurrently.
+type Metrics interface {
+	// Join records the duration of a Join.
+	Join(d time.Duration)
+
+	// Lookup records a single peers lookup: how long it took and how many
+	// new peers it found.
+	Lookup(d time.Duration, peers int)
+
+	// Provide records an announcement of a network in the DHT.
+	Provide(d time.Duration, err error)
+
+	// Bootstrap records the outcome of a connection to a bootstrap peer.
+	Bootstrap(err error)
+
+	// StreamError records a failure to exchange node information with a
+	// peer. op is either "encode" or "decode".
+	StreamError(op string, err error)
+}
+
+// nopMetrics discards all measurements.
+type nopMetrics struct{}
+
+func (nopMetrics) Join(time.Duration)           {}
+func (nopMetrics) Lookup(time.Duration, int)    {}
+func (nopMetrics) Provide(time.Duration, error) {}
+func (nopMetrics) Bootstrap(error)              {}
+func (nopMetrics) StreamError(string, error)    {}
@@ -0,0 +1,152 @@
+package discovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+
+	protocol "github.com/ipsn/go-ipfs/gxlibs/github.com/libp2p/go-libp2p-protocol"
+)
+
+const (
+	// protocolV1 sends a bare PeerInfo.
+	protocolV1 = "0.1.0"
+	// protocolV2 wraps the PeerInfo in a versioned envelope.
+	protocolV2 = "0.2.0"
+	// protocolV3 starts with a request naming the chain the requester is
+	// interested in, so a node can serve several networks.
+	protocolV3 = "0.3.0"
+)
+
+// protocolVersions lists the supported stream protocol versions, newest first.
+var protocolVersions = []string{protocolV3, protocolV2, protocolV1}
+
+// peerMessage is the envelope exchanged starting with protocolV2.
+// New fields can be added here without breaking older peers.
+type peerMessage struct {
+	Version string    `json:"version"`
+	Peer    *PeerInfo `json:"peer"`
+	Error   string    `json:"error,omitempty"`
+}
+
+var (
+	// errNotServed is answered for the chains a node doesn't serve.
+	errNotServed = remoteError("network not served by this node")
+	// errNoChainRequested is answered to requests not naming a chain.
+	errNoChainRequested = remoteError("no network requested, the peer needs to be upgraded")
+)
+
+// remoteError is an error reported by the remote peer.
+type remoteError string
+
+func (e remoteError) Error() string {
+	return string(e)
+}
+
+// peerRequest is sent by the requester starting with protocolV3.
+type peerRequest struct {
+	ChainID string `json:"chain_id"`
+}
+
+// protocolID returns the libp2p protocol ID for the given namespace and
+// version, e.g. /chainkit/<namespace>/0.3.0. Without namespace, this is the
+// historical /chainkit/0.3.0.
+func protocolID(namespace, version string) protocol.ID {
+	return protocol.ID(path.Join("/chainkit", namespace, version))
+}
+
+// protocolIDs returns the supported protocol IDs in namespace, newest first.
+func protocolIDs(namespace string) []protocol.ID {
+	ids := make([]protocol.ID, 0, len(protocolVersions))
+	for _, v := range protocolVersions {
+		ids = append(ids, protocolID(namespace, v))
+	}
+	return ids
+}
+
+// protocolVersion returns the version of a protocol ID.
+func protocolVersion(id protocol.ID) string {
+	return path.Base(string(id))
+}
+
+// encodePeer writes peer to w using the given protocol version.
+func encodePeer(w io.Writer, version string, peer *PeerInfo) error {
+	enc := json.NewEncoder(w)
+	switch version {
+	case protocolV1:
+		return enc.Encode(peer)
+	case protocolV2, protocolV3:
+		if peer == nil {
+			return encodeError(w, version, errNotServed)
+		}
+		return enc.Encode(&peerMessage{
+			Version: version,
+			Peer:    peer,
+		})
+	}
+	return fmt.Errorf("unsupported protocol version %q", version)
+}
+
+// encodeError reports err to the requester. protocolV1 has no way to report
+// errors: nothing is written.
+func encodeError(w io.Writer, version string, err error) error {
+	switch version {
+	case protocolV1:
+		return nil
+	case protocolV2, protocolV3:
+		return json.NewEncoder(w).Encode(&peerMessage{
+			Version: version,
+			Error:   err.Error(),
+		})
+	}
+	return fmt.Errorf("unsupported protocol version %q", version)
+}
+
+// encodeRequest writes the request for the node information of chainID.
+// Only protocolV3 and later send a request.
+func encodeRequest(w io.Writer, version, chainID string) error {
+	if version != protocolV3 {
+		return nil
+	}
+	return json.NewEncoder(w).Encode(&peerRequest{ChainID: chainID})
+}
+
+// decodeRequest reads the chain ID requested by the remote peer. Returns an
+// empty chain ID for protocol versions without requests.
+func decodeRequest(r io.Reader, version string) (string, error) {
+	if version != protocolV3 {
+		return "", nil
+	}
+	req := &peerRequest{}
+	if err := json.NewDecoder(r).Decode(req); err != nil {
+		return "", err
+	}
+	return req.ChainID, nil
+}
+
+// decodePeer reads a peer from r using the given protocol version.
+func decodePeer(r io.Reader, version string) (*PeerInfo, error) {
+	dec := json.NewDecoder(r)
+	switch version {
+	case protocolV1:
+		peer := &PeerInfo{}
+		if err := dec.Decode(peer); err != nil {
+			return nil, err
+		}
+		return peer, nil
+	case protocolV2, protocolV3:
+		msg := &peerMessage{}
+		if err := dec.Decode(msg); err != nil {
+			return nil, err
+		}
+		if msg.Error != "" {
+			return nil, remoteError(msg.Error)
+		}
+		if msg.Peer == nil {
+			return nil, fmt.Errorf("missing peer information")
+		}
+		return msg.Peer, nil
+	}
+	return nil, fmt.Errorf("unsupported protocol version %q", version)
+}
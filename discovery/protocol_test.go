@@ -0,0 +1,151 @@
+package discovery
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func testPeer() *PeerInfo {
+	return &PeerInfo{
+		NodeID:            "node",
+		IP:                []string{"10.0.0.1", "192.168.0.1"},
+		TendermintP2PPort: 26656,
+	}
+}
+
+func TestPeerRoundTrip(t *testing.T) {
+	for _, version := range protocolVersions {
+		t.Run(version, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := encodePeer(&buf, version, testPeer()); err != nil {
+				t.Fatal(err)
+			}
+			peer, err := decodePeer(&buf, version)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(peer, testPeer()) {
+				t.Fatalf("decoded %+v, want %+v", peer, testPeer())
+			}
+		})
+	}
+}
+
+func TestPeerNotServed(t *testing.T) {
+	for _, version := range []string{protocolV2, protocolV3} {
+		t.Run(version, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := encodePeer(&buf, version, nil); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := decodePeer(&buf, version); err == nil {
+				t.Fatal("expected an error for a network not served")
+			}
+		})
+	}
+}
+
+// TestPeerNewerSchema checks that peers still exchange the common fields
+// with a peer sending a newer PeerInfo, or a newer envelope.
+func TestPeerNewerSchema(t *testing.T) {
+	type newerPeerInfo struct {
+		PeerInfo
+		Moniker string `json:"moniker"`
+	}
+	newer := &newerPeerInfo{PeerInfo: *testPeer(), Moniker: "moniker"}
+
+	messages := map[string]interface{}{
+		protocolV1: newer,
+		protocolV2: map[string]interface{}{
+			"version": protocolV2,
+			"peer":    newer,
+			"extra":   "ignored",
+		},
+		protocolV3: map[string]interface{}{
+			"version": protocolV3,
+			"peer":    newer,
+			"extra":   "ignored",
+		},
+	}
+	for version, msg := range messages {
+		t.Run(version, func(t *testing.T) {
+			data, err := json.Marshal(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			peer, err := decodePeer(bytes.NewReader(data), version)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(peer, testPeer()) {
+				t.Fatalf("decoded %+v, want %+v", peer, testPeer())
+			}
+		})
+	}
+}
+
+// TestPeerV1DecodesV2Peer checks that a v1 peer talking to a v2 node, which
+// answers in the v1 format once negotiated, gets the peer information.
+func TestPeerV1DecodesV2Peer(t *testing.T) {
+	var buf bytes.Buffer
+	if err := encodePeer(&buf, protocolV1, testPeer()); err != nil {
+		t.Fatal(err)
+	}
+	// A v1 peer decodes a bare PeerInfo.
+	peer := &PeerInfo{}
+	if err := json.NewDecoder(&buf).Decode(peer); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(peer, testPeer()) {
+		t.Fatalf("decoded %+v, want %+v", peer, testPeer())
+	}
+}
+
+// TestPeerVersionMismatch checks that a bare v1 PeerInfo isn't silently
+// decoded as a v2 envelope.
+func TestPeerVersionMismatch(t *testing.T) {
+	var buf bytes.Buffer
+	if err := encodePeer(&buf, protocolV1, testPeer()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := decodePeer(&buf, protocolV2); err == nil {
+		t.Fatal("expected an error decoding a v1 message as v2")
+	}
+}
+
+func TestRequestRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	if err := encodeRequest(&buf, protocolV3, "chain"); err != nil {
+		t.Fatal(err)
+	}
+	chainID, err := decodeRequest(&buf, protocolV3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chainID != "chain" {
+		t.Fatalf("decoded chain ID %q, want %q", chainID, "chain")
+	}
+
+	// Older versions send no request.
+	for _, version := range []string{protocolV1, protocolV2} {
+		buf.Reset()
+		if err := encodeRequest(&buf, version, "chain"); err != nil {
+			t.Fatal(err)
+		}
+		if buf.Len() != 0 {
+			t.Fatalf("%s request wrote %q", version, buf.String())
+		}
+	}
+}
+
+func TestUnsupportedVersion(t *testing.T) {
+	var buf bytes.Buffer
+	if err := encodePeer(&buf, "9.9.9", testPeer()); err == nil {
+		t.Fatal("expected an error encoding an unsupported version")
+	}
+	if _, err := decodePeer(bytes.NewReader([]byte("{}")), "9.9.9"); err == nil {
+		t.Fatal("expected an error decoding an unsupported version")
+	}
+}
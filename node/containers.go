@@ -0,0 +1,58 @@
+package node
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/blocklayerhq/bitcoinx/config"
+	"github.com/blocklayerhq/bitcoinx/util"
+	"github.com/pkg/errors"
+)
+
+// containerLabels are the label filters matching each kind of container
+// started for the project running in cfg.RootDir.
+func containerLabels(cfg *config.Config, projectName string) [][]string {
+	root := util.DockerRootLabel(cfg)
+	return [][]string{
+		{"chainkit.cosmos.daemon", "chainkit.project=" + projectName, root},
+		{"bitcoinx.cosmos.explorer", "bitcoinx.project=" + projectName, root},
+	}
+}
+
+// ListContainers returns the IDs of the running containers (node and
+// explorer) belonging to the project running in cfg.RootDir. The containers
+// of other networks joined from the same project are left out.
+func ListContainers(ctx context.Context, cfg *config.Config, projectName string) ([]string, error) {
+	ids := []string{}
+	for _, labels := range containerLabels(cfg, projectName) {
+		args := []string{"ps", "-q"}
+		for _, l := range labels {
+			args = append(args, "--filter", "label="+l)
+		}
+		out, err := util.Output(ctx, "docker", args...)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list containers")
+		}
+		ids = append(ids, strings.Fields(string(out))...)
+	}
+	return ids, nil
+}
+
+// StopContainers stops all the containers belonging to the project running
+// in cfg.RootDir.
+func StopContainers(ctx context.Context, cfg *config.Config, projectName string) error {
+	ids, err := ListContainers(ctx, cfg, projectName)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	args := append([]string{"stop"}, ids...)
+	if err := util.RunWithFD(ctx, os.Stdin, ioutil.Discard, os.Stderr, "docker", args...); err != nil {
+		return errors.Wrap(err, "unable to stop containers")
+	}
+	return nil
+}
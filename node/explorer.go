@@ -6,22 +6,35 @@ import (
 
 	"github.com/blocklayerhq/bitcoinx/config"
 	"github.com/blocklayerhq/bitcoinx/project"
+	"github.com/blocklayerhq/bitcoinx/ui"
 	"github.com/blocklayerhq/bitcoinx/util"
 	"github.com/pkg/errors"
 )
 
-// explorerImage defines the container image to pull for running the Bitcoinx Explorer
-const explorerImage = "samalba/bitcoinx-explorer-localdev:20181204"
+func startExplorer(ctx context.Context, cfg *config.Config, p *project.Project) error {
+	image := cfg.ExplorerImage
+	if image == "" {
+		image = config.ExplorerImageDefault
+	}
+
+	if err := PullImage(ctx, image); err != nil {
+		return err
+	}
 
-func startExplorer(ctx context.Context, config *config.Config, p *project.Project) error {
 	cmd := []string{
 		"run", "--rm",
-		"-p", fmt.Sprintf("%d:8080", config.Ports.Explorer),
+		"-p", fmt.Sprintf("%d:8080", cfg.Ports.Explorer),
 		"-l", "bitcoinx.cosmos.explorer",
 		"-l", "bitcoinx.project=" + p.Name,
-		explorerImage,
+		"-l", util.DockerRootLabel(cfg),
 	}
-	if err := util.Run(ctx, "docker", cmd...); err != nil {
+	cmd = append(cmd, util.DockerResourceFlags(cfg)...)
+	cmd = append(cmd, image)
+
+	// Tag the explorer output so it can be told apart from the node's.
+	w := ui.PrefixWriter("explorer")
+	defer w.Close()
+	if err := util.RunWithFD(ctx, nil, w, w, "docker", cmd...); err != nil {
 		return errors.Wrap(err, "failed to start the explorer")
 	}
 	return nil
@@ -0,0 +1,60 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/blocklayerhq/bitcoinx/config"
+	"github.com/blocklayerhq/bitcoinx/ui"
+	"github.com/pkg/errors"
+)
+
+const (
+	// healthTimeoutDefault is how long to wait for the node to become healthy.
+	healthTimeoutDefault = 2 * time.Minute
+	// healthInterval is the delay between health checks.
+	healthInterval = 200 * time.Millisecond
+)
+
+// WaitHealthy blocks until the Tendermint RPC of the node responds, or until
+// timeout expires.
+func WaitHealthy(ctx context.Context, config *config.Config, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	url := fmt.Sprintf("http://%s/status", config.LocalRPCAddress())
+	start := time.Now()
+	for {
+		if err := checkHealth(ctx, url); err == nil {
+			return nil
+		}
+		ui.Live(fmt.Sprintf("Waiting for the node to be ready (%s)", time.Since(start).Round(time.Second)))
+
+		select {
+		case <-time.After(healthInterval):
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				return errors.Errorf("node not ready after %v", timeout)
+			}
+			return ctx.Err()
+		}
+	}
+}
+
+func checkHealth(ctx context.Context, url string) error {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request failed with code %d", resp.StatusCode)
+	}
+	return nil
+}
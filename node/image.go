@@ -0,0 +1,55 @@
+package node
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/blocklayerhq/bitcoinx/ui"
+	"github.com/blocklayerhq/bitcoinx/util"
+	"github.com/pkg/errors"
+)
+
+// PullImage pulls image unless it's already available locally, reporting the
+// progress as it goes. Pulling ahead of `docker run` keeps the startup from
+// looking frozen on a cold cache.
+func PullImage(ctx context.Context, image string) error {
+	if ImageExists(ctx, image) {
+		return nil
+	}
+
+	ui.Info("Pulling image %s", ui.Emphasize(image))
+
+	r, w := io.Pipe()
+	doneCh := make(chan struct{})
+	go func() {
+		defer close(doneCh)
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			ui.Live(scanner.Text())
+		}
+		io.Copy(ioutil.Discard, r)
+	}()
+
+	var stderr bytes.Buffer
+	err := util.RunWithTimeout(ctx, util.PullTimeout, nil, w, &stderr, "docker", "pull", image)
+	w.Close()
+	<-doneCh
+
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return errors.Errorf("unable to pull %s: %s", image, msg)
+		}
+		return errors.Wrapf(err, "unable to pull %s", image)
+	}
+	return nil
+}
+
+// ImageExists returns true if image is available locally.
+func ImageExists(ctx context.Context, image string) bool {
+	_, err := util.Output(ctx, "docker", "image", "inspect", image)
+	return err == nil
+}
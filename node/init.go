@@ -82,7 +82,7 @@ func fixFsPermissions(ctx context.Context, config *config.Config, p *project.Pro
 		p.Image + ":latest",
 		"chown", "-R", user, daemonDir, cliDir,
 	}
-	if err := util.Run(ctx, "docker", cmd...); err != nil {
+	if err := util.RunWithTimeout(ctx, util.RunTimeout, os.Stdin, os.Stdout, os.Stderr, "docker", cmd...); err != nil {
 		return errors.Wrap(err, "Cannot change directories permissions")
 	}
 	return nil
@@ -0,0 +1,79 @@
+package node
+
+import (
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// logFileMaxSize is the size after which the log file gets rotated.
+const logFileMaxSize = 10 * 1024 * 1024
+
+// logFile is a log file rotated once it grows past a maximum size. The
+// previous log is kept with a ".1" suffix.
+type logFile struct {
+	mu      sync.Mutex
+	path    string
+	maxSize int64
+	f       *os.File
+	size    int64
+}
+
+func openLogFile(path string, maxSize int64) (*logFile, error) {
+	l := &logFile{
+		path:    path,
+		maxSize: maxSize,
+	}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *logFile) open() error {
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return errors.Wrap(err, "unable to open log file")
+	}
+	st, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	l.f = f
+	l.size = st.Size()
+	return nil
+}
+
+func (l *logFile) rotate() error {
+	if err := l.f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(l.path, l.path+".1"); err != nil {
+		return errors.Wrap(err, "unable to rotate log file")
+	}
+	return l.open()
+}
+
+// Write implements io.Writer.
+func (l *logFile) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.size > 0 && l.size+int64(len(p)) > l.maxSize {
+		if err := l.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := l.f.Write(p)
+	l.size += int64(n)
+	return n, err
+}
+
+// Close implements io.Closer.
+func (l *logFile) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.f.Close()
+}
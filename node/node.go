@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/blocklayerhq/bitcoinx/config"
@@ -20,9 +22,19 @@ import (
 type Node struct {
 	config *config.Config
 
+	stopCh   chan struct{}
+	stopOnce sync.Once
+
+	// mu protects the fields of the current run, which Start reassigns on
+	// every restart while Stop reads them.
+	mu        sync.Mutex
 	parentCtx context.Context
 	cancelCtx context.CancelFunc
 	doneCh    chan struct{}
+	// projectName is the name of the project being run.
+	projectName string
+	// onReady is called once the node is up and running.
+	onReady func(*Status)
 
 	server    *server
 	discovery *discovery.Server
@@ -32,24 +44,57 @@ type Node struct {
 func New(config *config.Config, discovery *discovery.Server) *Node {
 	return &Node{
 		config:    config,
-		server:    newServer(config),
 		discovery: discovery,
+		stopCh:    make(chan struct{}),
 	}
 }
 
+// NotifyReady registers fn to be called with the node status every time the
+// node is up and running.
+func (n *Node) NotifyReady(fn func(*Status)) {
+	n.onReady = fn
+}
+
 // Stop stops the node and returns once fully stopped.
 func (n *Node) Stop() {
-	n.cancelCtx()
-	<-n.doneCh
+	// Once stopping, Start doesn't begin new runs: the current one, if
+	// any, is the last.
+	n.stopOnce.Do(func() { close(n.stopCh) })
+	n.mu.Lock()
+	cancel, done, projectName := n.cancelCtx, n.doneCh, n.projectName
+	n.mu.Unlock()
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+
+	// Make sure no container outlives the node.
+	if projectName != "" {
+		if err := StopContainers(context.Background(), n.config, projectName); err != nil {
+			ui.Error("Failed to stop containers: %v", err)
+		}
+	}
 }
 
 // Start starts the node. It will not return until it finishes
 // starting.
 func (n *Node) Start(ctx context.Context, p *project.Project, genesis []byte, editGenesis bool) error {
+	n.mu.Lock()
+	if n.stopping() {
+		n.mu.Unlock()
+		return nil
+	}
 	n.parentCtx, n.cancelCtx = context.WithCancel(ctx)
-
-	n.doneCh = make(chan struct{})
-	defer close(n.doneCh)
+	n.projectName = p.Name
+	done := make(chan struct{})
+	n.doneCh = done
+	n.mu.Unlock()
+	defer close(done)
+	defer n.cancelCtx()
+
+	if err := PullImage(n.parentCtx, p.Image+":latest"); err != nil {
+		return err
+	}
 
 	if err := n.init(ctx, p, genesis, editGenesis); err != nil {
 		return err
@@ -70,35 +115,68 @@ func (n *Node) Start(ctx context.Context, p *project.Project, genesis []byte, ed
 			ui.Emphasize(chainID),
 			ui.Emphasize(fmt.Sprintf("bitcoinx join %s", chainID)),
 		)
+
+		if n.config.Alias != "" {
+			if err := n.discovery.PublishAlias(n.parentCtx, n.config.Alias, chainID); err != nil {
+				return errors.Wrap(err, "unable to publish alias")
+			}
+			ui.Success("Network %s is also available locally as %s", ui.Emphasize(chainID), ui.Emphasize(n.config.Alias))
+		}
 	}
 
+	// Every run gets its own server, whose container stops as soon as any
+	// part of the run fails.
+	g, gctx := errgroup.WithContext(n.parentCtx)
+	n.server = newServer(n.config)
+
 	ui.Info("Starting node...")
-	if err := n.server.start(n.parentCtx, p); err != nil {
+	if err := n.server.start(gctx, p); err != nil {
 		return err
 	}
 
-	peer, err := n.server.peerInfo(n.parentCtx)
+	// Monitor the server
+	g.Go(func() error {
+		return n.server.wait()
+	})
+
+	peer, err := n.server.peerInfo(gctx)
 	if err != nil {
+		n.cancelCtx()
+		g.Wait()
 		return err
 	}
 
 	ui.Success("Success! The node is now up and running.")
 	ui.Success("  Node ID                   : %s", ui.Emphasize(peer.NodeID))
-	ui.Success("  Logs can be found in      : %s", ui.Emphasize(n.config.LogFile()))
-	ui.Success("  Application is live at    : %s", ui.Emphasize(fmt.Sprintf("http://localhost:%d/", n.config.Ports.TendermintRPC)))
-	ui.Success("  BitcoinX Explorer is live at: %s", ui.Emphasize(fmt.Sprintf("http://localhost:%d/?rpc_port=%d", n.config.Ports.Explorer, n.config.Ports.TendermintRPC)))
-
-	g, gctx := errgroup.WithContext(n.parentCtx)
+	ui.Success("  Discovery peer ID         : %s", ui.Emphasize(n.discovery.ID()))
+	if !n.config.DisableLogFile {
+		ui.Success("  Logs can be found in      : %s", ui.Emphasize(n.config.LogFile()))
+	}
+	ui.Success("  Application is live at    : %s", ui.Emphasize(n.config.RPCURL()))
+	if !n.config.DisableExplorer {
+		ui.Success("  BitcoinX Explorer is live at: %s", ui.Emphasize(n.config.ExplorerURL()))
+	}
 
-	// Monitor the server
-	g.Go(func() error {
-		return n.server.wait()
-	})
+	status := &Status{
+		Project:     p.Name,
+		ChainID:     chainID,
+		NodeID:      peer.NodeID,
+		DiscoveryID: n.discovery.ID(),
+		Explorer:    !n.config.DisableExplorer,
+		Ports:       n.config.Ports,
+		RPCURL:      n.config.RPCURL(),
+		RPCAddress:  n.config.LocalRPCAddress(),
+	}
+	if n.onReady != nil {
+		n.onReady(status)
+	}
 
 	// Start the explorer.
-	g.Go(func() error {
-		return startExplorer(gctx, n.config, p)
-	})
+	if !n.config.DisableExplorer {
+		g.Go(func() error {
+			return startExplorer(gctx, n.config, p)
+		})
+	}
 
 	// Announce
 	g.Go(func() error {
@@ -110,6 +188,11 @@ func (n *Node) Start(ctx context.Context, p *project.Project, genesis []byte, ed
 		return n.discoverPeers(gctx, chainID)
 	})
 
+	// Report the node status
+	g.Go(func() error {
+		return n.reportStatus(gctx, status)
+	})
+
 	return g.Wait()
 }
 
@@ -125,18 +208,18 @@ func (n *Node) init(ctx context.Context, p *project.Project, genesis []byte, edi
 		return errors.Wrap(err, "initialization failed")
 	}
 
-	err = updateConfig(
-		n.config.ConfigPath(),
-		map[string]string{
-			// Set custom moniker. Needed to join nodes together.
-			"moniker": fmt.Sprintf("%q", moniker),
-			// Needed to join local/private networks.
-			"addr_book_strict": "false",
-			// Needed to enable dial_seeds
-			"unsafe": "true",
-		},
-	)
-	if err != nil {
+	vars := map[string]string{
+		// Set custom moniker. Needed to join nodes together.
+		"moniker": fmt.Sprintf("%q", moniker),
+		// Needed to join local/private networks.
+		"addr_book_strict": "false",
+		// Needed to enable dial_seeds
+		"unsafe": "true",
+	}
+	if len(n.config.PersistentPeers) > 0 {
+		vars["persistent_peers"] = fmt.Sprintf("%q", strings.Join(n.config.PersistentPeers, ","))
+	}
+	if err := updateConfig(n.config.ConfigPath(), vars); err != nil {
 		return err
 	}
 
@@ -151,17 +234,29 @@ func (n *Node) init(ctx context.Context, p *project.Project, genesis []byte, edi
 	return nil
 }
 
-func (n *Node) createNetwork(ctx context.Context, p *project.Project) (string, error) {
+// SaveImage saves the project image into a temporary tarball and returns its
+// path. The caller is responsible for removing it.
+func SaveImage(ctx context.Context, p *project.Project) (string, error) {
 	f, err := ioutil.TempFile(os.TempDir(), "bitcoinx-image")
 	if err != nil {
 		return "", errors.Wrap(err, "unable to create temporary file")
 	}
+	defer f.Close()
 	if err := util.RunWithFD(ctx, os.Stdin, f, os.Stderr, "docker", "save", p.Image); err != nil {
+		os.Remove(f.Name())
 		return "", errors.Wrap(err, "unable to save image")
 	}
-	f.Close()
+	return f.Name(), nil
+}
+
+func (n *Node) createNetwork(ctx context.Context, p *project.Project) (string, error) {
+	image, err := SaveImage(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(image)
 
-	chainID, err := n.discovery.Publish(ctx, n.config.ManifestPath(), n.config.GenesisPath(), f.Name())
+	chainID, err := n.discovery.Publish(ctx, n.config.ManifestPath(), n.config.GenesisPath(), image)
 	if err != nil {
 		return "", errors.Wrap(err, "unable to create network")
 	}
@@ -170,6 +265,11 @@ func (n *Node) createNetwork(ctx context.Context, p *project.Project) (string, e
 }
 
 func (n *Node) announce(ctx context.Context, chainID string, peer *discovery.PeerInfo) error {
+	if n.discovery.Observing() {
+		ui.Info("Observing the network, this node will not be discoverable")
+		return nil
+	}
+
 	ui.Info("Registering this node with the network...")
 	for {
 		select {
@@ -192,6 +292,36 @@ func (n *Node) announce(ctx context.Context, chainID string, peer *discovery.Pee
 	}
 }
 
+// addPersistentPeers adds peers to the persistent peers of the node so it
+// reconnects to them when restarted.
+func (n *Node) addPersistentPeers(peers []string) error {
+	known := make(map[string]struct{})
+	for _, p := range n.config.PersistentPeers {
+		known[p] = struct{}{}
+	}
+	changed := false
+	for _, p := range peers {
+		if _, ok := known[p]; ok {
+			continue
+		}
+		n.config.PersistentPeers = append(n.config.PersistentPeers, p)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return updateConfig(n.config.ConfigPath(), map[string]string{
+		"persistent_peers": fmt.Sprintf("%q", strings.Join(n.config.PersistentPeers, ",")),
+	})
+}
+
+// closedPeerCh stands for an empty peer lookup.
+var closedPeerCh = func() <-chan *discovery.PeerInfo {
+	ch := make(chan *discovery.PeerInfo)
+	close(ch)
+	return ch
+}()
+
 func (n *Node) discoverPeers(ctx context.Context, chainID string) error {
 	ui.Info("Discovering network nodes...")
 
@@ -206,7 +336,13 @@ func (n *Node) discoverPeers(ctx context.Context, chainID string) error {
 		}
 
 		peerCh, err := n.discovery.Peers(ctx, chainID)
-		if err != nil {
+		switch {
+		case err == discovery.ErrNotConnected:
+			// The node may have started offline from its local network
+			// files: keep trying until the network is reachable.
+			ui.Verbose("Unable to look for network nodes: %v", err)
+			peerCh = closedPeerCh
+		case err != nil:
 			return err
 		}
 
@@ -219,6 +355,9 @@ func (n *Node) discoverPeers(ctx context.Context, chainID string) error {
 				ui.Error("Failed to dial peer: %v", err)
 				continue
 			}
+			if err := n.addPersistentPeers(PeerAddrs(peer)); err != nil {
+				ui.Warn("Unable to persist peer %s: %v", peer.NodeID, err)
+			}
 
 			seenNodes[peer.NodeID] = struct{}{}
 		}
@@ -0,0 +1,173 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blocklayerhq/bitcoinx/config"
+	"github.com/blocklayerhq/bitcoinx/discovery"
+	"github.com/blocklayerhq/bitcoinx/project"
+)
+
+func testConfig(t *testing.T) *config.Config {
+	root, err := ioutil.TempDir("", "node-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(root) })
+	return &config.Config{
+		RootDir: root,
+		Ports:   &config.PortMapper{},
+	}
+}
+
+func TestStopBeforeStart(t *testing.T) {
+	n := New(testConfig(t), nil)
+	n.Stop()
+	if err := n.Start(context.Background(), project.New("app"), nil, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// fakeDocker puts a docker command on the PATH whose node containers run
+// until terminated. Returns the file logging the containers starting and
+// stopping.
+func fakeDocker(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "node-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	log := path.Join(dir, "containers.log")
+	script := `#!/bin/sh
+case "$*" in
+run*" start")
+	trap 'echo stopped >> ` + log + `; exit 1' TERM
+	echo started >> ` + log + `
+	while :; do sleep 0.05; done
+	;;
+esac
+`
+	if err := ioutil.WriteFile(path.Join(dir, "docker"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return log
+}
+
+// fakeNode returns a node whose containers are run by fakeDocker, with a
+// fake RPC for them. The node fails shortly after coming up: its offline
+// discovery can't look for peers.
+func fakeNode(t *testing.T) (*Node, *project.Project, string) {
+	log := fakeDocker(t)
+
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			return
+		}
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":"","result":{"node_info":{"id":"node"}}}`)
+	}))
+	t.Cleanup(rpc.Close)
+	rpcPort, err := strconv.Atoi(strings.TrimPrefix(rpc.URL, "http://127.0.0.1:"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := testConfig(t)
+	cfg.ChainID = "QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn"
+	cfg.DisableExplorer = true
+	cfg.DisableLogFile = true
+	cfg.Ports.TendermintRPC = rpcPort
+	cfg.Ports.TendermintP2P = 26656
+	if err := cfg.EnsureDirs(); err != nil {
+		t.Fatal(err)
+	}
+	// The node is already initialized.
+	for _, file := range []string{cfg.GenesisPath(), cfg.ConfigPath()} {
+		if err := ioutil.WriteFile(file, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	d, err := discovery.New(cfg.IPFSDir(), 0, discovery.Options{
+		Offline: true,
+		Routing: discovery.RoutingNone,
+		KeyType: discovery.KeyTypeEd25519,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { d.Stop() })
+
+	return New(cfg, d), project.New("app"), log
+}
+
+// containerEvents returns the containers starting and stopping, in order.
+func containerEvents(t *testing.T, log string) []string {
+	data, err := ioutil.ReadFile(log)
+	if err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+	return strings.Fields(string(data))
+}
+
+func TestRestart(t *testing.T) {
+	n, p, log := fakeNode(t)
+
+	err := n.StartSupervised(context.Background(), p, nil, RestartPolicy{
+		MaxRestarts: 1,
+		Delay:       time.Millisecond,
+	})
+	if err == nil {
+		t.Fatal("expected the node to fail")
+	}
+
+	// Every run started its own container, stopped before the next run.
+	want := []string{"started", "stopped", "started", "stopped"}
+	if events := containerEvents(t, log); !reflect.DeepEqual(events, want) {
+		t.Fatalf("containers %v, want %v", events, want)
+	}
+}
+
+func TestStopWhileRestarting(t *testing.T) {
+	n, p, log := fakeNode(t)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- n.StartSupervised(context.Background(), p, nil, RestartPolicy{
+			MaxRestarts: 1000,
+			Delay:       10 * time.Millisecond,
+			MaxDelay:    10 * time.Millisecond,
+		})
+	}()
+
+	// Stop once the node has been restarted.
+	for len(containerEvents(t, log)) < 3 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	n.Stop()
+	select {
+	case <-errCh:
+	case <-time.After(10 * time.Second):
+		t.Fatal("node still running after Stop")
+	}
+
+	events := containerEvents(t, log)
+	if len(events)%2 != 0 || events[len(events)-1] != "stopped" {
+		t.Fatalf("container left running: %v", events)
+	}
+}
@@ -3,11 +3,13 @@ package node
 import (
 	"context"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
-	"time"
 
 	"github.com/blocklayerhq/bitcoinx/config"
 	"github.com/blocklayerhq/bitcoinx/discovery"
@@ -15,66 +17,83 @@ import (
 	"github.com/blocklayerhq/bitcoinx/util"
 	"github.com/pkg/errors"
 	"github.com/tendermint/tendermint/rpc/client"
+	"golang.org/x/crypto/ssh/terminal"
 )
 
+// errNodeExited is returned when the node container exits on its own.
+var errNodeExited = errors.New("node exited")
+
+// server runs the node container. A server runs a single container: a new
+// one is needed to run the node again.
 type server struct {
 	config *config.Config
 	errCh  chan error
+	cancel context.CancelFunc
 	rpc    *client.HTTP
 }
 
 func newServer(config *config.Config) *server {
 	return &server{
 		config: config,
-		errCh:  make(chan error),
+		errCh:  make(chan error, 1),
 		rpc: client.NewHTTP(
-			fmt.Sprintf("http://localhost:%d", config.Ports.TendermintRPC),
-			fmt.Sprintf("http://localhost:%d/websocket", config.Ports.TendermintRPC),
+			fmt.Sprintf("http://%s", config.LocalRPCAddress()),
+			fmt.Sprintf("http://%s/websocket", config.LocalRPCAddress()),
 		),
 	}
 }
 
-// waitReady blocks until the node is ready.
-func (s *server) waitReady(ctx context.Context) error {
-	for {
-		_, err := s.rpc.Status()
-		if err == nil {
-			return nil
+// start starts the server and returns when it's up and running. The container
+// runs until ctx is done. If the server doesn't come up, the container is
+// stopped before returning.
+func (s *server) start(ctx context.Context, p *project.Project) error {
+	var (
+		stdout io.Writer = os.Stdout
+		stderr io.Writer = os.Stderr
+		log    *logFile
+	)
+	if !s.config.DisableLogFile {
+		var err error
+		log, err = openLogFile(s.config.LogFile(), logFileMaxSize)
+		if err != nil {
+			return err
 		}
-		select {
-		case <-time.After(200 * time.Millisecond):
-		case <-ctx.Done():
-			return ctx.Err()
+		// Errors are still printed so failures are noticed right away, and
+		// the whole output when running interactively.
+		stdout = log
+		if terminal.IsTerminal(int(os.Stdout.Fd())) {
+			stdout = io.MultiWriter(log, os.Stdout)
 		}
-	}
-}
-
-// start starts the server and returns when it's up and running.
-func (s *server) start(ctx context.Context, p *project.Project) error {
-	logFile, err := os.OpenFile(s.config.LogFile(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
-	if err != nil {
-		return errors.Wrap(err, "unable to open log file")
+		stderr = io.MultiWriter(log, os.Stderr)
 	}
 
 	// Spin the server on the background.
+	ctx, s.cancel = context.WithCancel(ctx)
 	go func() {
-		defer close(s.errCh)
-		s.errCh <- util.DockerRunWithFD(ctx, s.config, p, os.Stdin, logFile, os.Stderr, "start")
+		if log != nil {
+			defer log.Close()
+		}
+		s.errCh <- util.DockerRunWithFD(ctx, s.config, p, os.Stdin, stdout, stderr, "start")
 	}()
 
 	// Wait for the server to be ready.
-	waitCh := make(chan error)
+	waitCh := make(chan error, 1)
 	go func() {
-		defer close(waitCh)
-		waitCh <- s.waitReady(ctx)
+		waitCh <- WaitHealthy(ctx, s.config, healthTimeoutDefault)
 	}()
 
 	// Now we wait for the server to come up, or to error out.
 	select {
 	case err := <-s.errCh:
+		s.cancel()
+		if err == nil {
+			err = errNodeExited
+		}
 		return err
 	case err := <-waitCh:
 		if err != nil {
+			s.cancel()
+			<-s.errCh
 			return err
 		}
 	}
@@ -82,9 +101,14 @@ func (s *server) start(ctx context.Context, p *project.Project) error {
 	return nil
 }
 
-// wait waits until the server stops.
+// wait waits until the server stops. The node isn't expected to exit on its
+// own: errNodeExited is returned if it does so without error.
 func (s *server) wait() error {
-	return <-s.errCh
+	defer s.cancel()
+	if err := <-s.errCh; err != nil {
+		return err
+	}
+	return errNodeExited
 }
 
 // peerInfo retrieves PeerInfo from the underlying node
@@ -94,24 +118,35 @@ func (s *server) peerInfo(ctx context.Context) (*discovery.PeerInfo, error) {
 		return nil, err
 	}
 
-	return &discovery.PeerInfo{
-		NodeID:            string(status.NodeInfo.ID),
-		TendermintP2PPort: s.config.Ports.TendermintP2P,
-	}, nil
+	return discovery.NewPeerInfo(string(status.NodeInfo.ID), s.config.Ports.TendermintP2P), nil
+}
+
+// PeerAddrs returns the Tendermint addresses of a peer, one per IP, in the
+// "<node id>@<ip>:<port>" form.
+func PeerAddrs(peer *discovery.PeerInfo) []string {
+	addrs := []string{}
+	if peer == nil {
+		return addrs
+	}
+	for _, ip := range peer.IP {
+		addr := net.JoinHostPort(ip, strconv.Itoa(peer.TendermintP2PPort))
+		addrs = append(addrs, fmt.Sprintf("%s@%s", peer.NodeID, addr))
+	}
+	return addrs
 }
 
 // dialSeeds will add the given seeds to the underlying node.
 func (s *server) dialSeeds(ctx context.Context, peer *discovery.PeerInfo) error {
 	seeds := []string{}
-	for _, ip := range peer.IP {
-		seeds = append(seeds, fmt.Sprintf("\"%s@%s:%d\"", peer.NodeID, ip, peer.TendermintP2PPort))
+	for _, addr := range PeerAddrs(peer) {
+		seeds = append(seeds, fmt.Sprintf("%q", addr))
 	}
 	seedString := fmt.Sprintf("[%s]", strings.Join(seeds, ","))
 
 	client := &http.Client{}
 	req, err := http.NewRequest("GET",
-		fmt.Sprintf("http://localhost:%d/dial_seeds?seeds=%s",
-			s.config.Ports.TendermintRPC,
+		fmt.Sprintf("http://%s/dial_seeds?seeds=%s",
+			s.config.LocalRPCAddress(),
 			url.QueryEscape(seedString),
 		),
 		nil)
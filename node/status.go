@@ -0,0 +1,78 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/blocklayerhq/bitcoinx/config"
+	"github.com/pkg/errors"
+)
+
+// statusInterval is how often the status file gets refreshed.
+const statusInterval = 10 * time.Second
+
+// Status is the runtime information of a running node, persisted in the
+// status file so other commands can inspect it.
+type Status struct {
+	Project         string             `json:"project"`
+	ChainID         string             `json:"chain_id"`
+	NodeID          string             `json:"node_id"`
+	DiscoveryID     string             `json:"discovery_id"`
+	ListenAddresses []string           `json:"listen_addresses"`
+	ConnectedPeers  int                `json:"connected_peers"`
+	Explorer        bool               `json:"explorer"`
+	Ports           *config.PortMapper `json:"ports"`
+	// RPCURL is the advertised URL of the Tendermint RPC, and RPCAddress
+	// the address it is reachable at locally.
+	RPCURL     string    `json:"rpc_url,omitempty"`
+	RPCAddress string    `json:"rpc_address,omitempty"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// ReadStatus reads the status of the node running with the given config.
+// Returns os.ErrNotExist (checked with os.IsNotExist) if the node isn't running.
+func ReadStatus(config *config.Config) (*Status, error) {
+	data, err := ioutil.ReadFile(config.StatusFile())
+	if err != nil {
+		return nil, err
+	}
+	status := &Status{}
+	if err := json.Unmarshal(data, status); err != nil {
+		return nil, errors.Wrap(err, "unable to parse status file")
+	}
+	return status, nil
+}
+
+// writeStatus persists the current node status.
+func (n *Node) writeStatus(status *Status) error {
+	status.ListenAddresses = n.discovery.ListenAddresses()
+	status.ConnectedPeers = n.discovery.ConnectedPeers()
+	status.UpdatedAt = time.Now()
+
+	data, err := json.MarshalIndent(status, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(n.config.StatusFile(), data, 0644)
+}
+
+// reportStatus keeps the status file up to date until the context is
+// cancelled, at which point the file is removed.
+func (n *Node) reportStatus(ctx context.Context, status *Status) error {
+	defer os.Remove(n.config.StatusFile())
+
+	for {
+		if err := n.writeStatus(status); err != nil {
+			return errors.Wrap(err, "unable to write status file")
+		}
+
+		select {
+		case <-time.After(statusInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
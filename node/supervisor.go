@@ -0,0 +1,76 @@
+package node
+
+import (
+	"context"
+	"time"
+
+	"github.com/blocklayerhq/bitcoinx/project"
+	"github.com/blocklayerhq/bitcoinx/ui"
+)
+
+const (
+	// restartDelayDefault is the delay before the first restart.
+	restartDelayDefault = time.Second
+	// restartMaxDelayDefault caps the delay between restarts.
+	restartMaxDelayDefault = time.Minute
+)
+
+// RestartPolicy controls how a supervised node gets restarted.
+type RestartPolicy struct {
+	// MaxRestarts is the number of times the node gets restarted after a
+	// failure. Zero disables restarts.
+	MaxRestarts int
+	// Delay is the delay before the first restart. It doubles after every
+	// restart.
+	Delay time.Duration
+	// MaxDelay caps the delay between restarts.
+	MaxDelay time.Duration
+}
+
+// StartSupervised is like Start, but restarts the node when it fails
+// according to policy. Stopping the node doesn't trigger a restart.
+func (n *Node) StartSupervised(ctx context.Context, p *project.Project, genesis []byte, policy RestartPolicy) error {
+	delay := policy.Delay
+	if delay <= 0 {
+		delay = restartDelayDefault
+	}
+	maxDelay := policy.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = restartMaxDelayDefault
+	}
+
+	for restarts := 0; ; restarts++ {
+		err := n.Start(ctx, p, genesis, false)
+		if err == nil || n.stopping() || ctx.Err() != nil {
+			return err
+		}
+		if restarts >= policy.MaxRestarts {
+			return err
+		}
+
+		ui.Warn("Node failed: %v", err)
+		ui.Warn("Restarting in %v (%d/%d)", delay, restarts+1, policy.MaxRestarts)
+		select {
+		case <-time.After(delay):
+		case <-n.stopCh:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		delay *= 2
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+}
+
+// stopping returns true once Stop has been called.
+func (n *Node) stopping() bool {
+	select {
+	case <-n.stopCh:
+		return true
+	default:
+		return false
+	}
+}
@@ -21,137 +21,137 @@ var Assets = func() http.FileSystem {
 	fs := vfsgen۰FS{
 		"/": &vfsgen۰DirInfo{
 			name:    "/",
-			modTime: time.Date(2018, 12, 7, 0, 11, 56, 718735786, time.UTC),
+			modTime: time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 		},
 		"/.gitignore": &vfsgen۰CompressedFileInfo{
 			name:             ".gitignore",
-			modTime:          time.Date(2018, 12, 7, 0, 11, 56, 718932869, time.UTC),
+			modTime:          time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 			uncompressedSize: 220,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\x8c\xc1\x4a\x04\x31\x10\x44\xef\xfd\x15\x05\x7b\x0b\x6b\xf6\x1f\x44\x0f\x82\xe0\x41\x3f\x60\x33\x33\x3d\x99\x86\x76\x3a\x24\x1d\xd7\xb9\xf8\xed\x12\xdd\x4b\xf1\x8a\x2a\xde\x09\x8f\xb2\xa7\x2a\xdc\xb0\x5a\x45\xa9\x96\x6b\xfa\x6c\x48\xfb\x82\xa2\x3d\xcb\xde\x28\x44\xfe\xe6\xff\xfc\xa1\x10\x17\x55\x0a\xb1\xd9\xc0\x43\x65\x22\x3a\xe1\x83\x9b\x63\x1a\xa6\xe3\x8c\xa9\x8b\x2e\xb8\x89\x6f\xb8\x66\x83\x8f\xed\x61\xbe\x52\x88\x03\xc7\xfd\xad\x7b\xe9\x0e\x5b\xe1\x1b\x23\x1b\x66\xfb\xe2\x9a\x32\xc3\xcd\xf4\x8c\x56\x78\x96\x55\xe6\xa4\x7a\xe0\xb6\xf1\x8e\xde\xf8\xae\x7c\x15\xe7\x97\xa7\x67\x0a\xd1\xfa\x9f\xec\xdd\x93\x33\x56\xd3\x85\x2b\x5d\xda\x68\x74\x51\xcb\xf4\x1b\x00\x00\xff\xff\xde\x27\xf8\x7f\xdc\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xcc\xc1\x4a\x04\x31\x0c\xc6\xf1\x7b\x9e\xe2\x83\xbd\x95\xb5\xfb\x0e\xa2\x07\x41\xf0\xa0\x0f\xb0\x9d\x99\x4c\x27\x10\x27\xa5\x4d\x5d\xe7\xe2\xb3\x4b\x75\x2f\xe1\x0f\xf9\xf8\x9d\xf0\x28\x7b\xaa\xc2\x0d\xab\x55\x94\x6a\xb9\xa6\xcf\x86\xb4\x2f\x28\xda\xb3\xec\x8d\x42\xe4\x6f\xfe\xbf\x3f\x14\xe2\xa2\x4a\x21\x36\x1b\x79\xa8\x4c\x44\x27\x7c\x70\x73\x4c\x43\x3a\xce\x98\xba\xe8\x82\x9b\xf8\x86\x6b\x36\xf8\xf8\x3d\xcc\x57\x0a\x71\xe4\x98\xbf\x75\x2f\xdd\x61\x2b\x7c\x63\x64\xc3\x6c\x5f\x5c\x53\x66\xb8\x99\x9e\xd1\x0a\xcf\xb2\xca\x9c\x54\x0f\xdc\x36\xde\xd1\x1b\xdf\xc9\x57\x71\x7e\x79\x7a\xa6\x10\xad\xff\x61\xef\x9e\x9c\xb1\x9a\x2e\x5c\xe9\xd2\x3c\x39\xd3\x45\x2d\xd3\xef\x00\xde\x27\xf8\x7f\xdc\x00\x00\x00"),
 		},
 		"/Dockerfile.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "Dockerfile.tmpl",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 314037283, time.UTC),
-			uncompressedSize: 1140,
+			modTime:          time.Date(2026, 10, 16, 0, 53, 42, 0, time.UTC),
+			uncompressedSize: 1289,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x53\xcd\x6e\xdb\x3c\x10\xbc\xeb\x29\x06\xfe\x80\xe4\x44\xf1\x43\x8f\x29\x72\x48\x9c\x1f\x04\x6d\xe2\xc0\x41\x51\x14\x4d\x51\xd0\xe4\x4a\x22\x4c\x2d\x05\x92\x72\x22\x24\x7e\xf7\x42\x3f\xb0\x53\xc4\x97\x22\x37\x61\xb9\x3b\x9a\x99\xdd\xb9\x5a\x2e\x6e\x51\x7a\xa7\xb8\x3c\x51\xae\xb1\x4c\x38\x7b\xc0\xaa\xb5\xce\x08\xe2\x4d\x96\xfd\x87\x07\x4a\x78\xf2\x61\x6d\xb9\x84\xb1\x81\x74\xf2\xa1\x43\xe1\x03\x52\x45\x63\x6b\xf6\x7d\xb1\xfc\x72\x71\xb3\x84\x2c\xbd\x8c\x41\xcb\x97\x17\xe4\xd7\xfe\x7e\x5d\x62\xbb\x9d\x30\xda\x66\xec\x05\xf1\xc6\x06\xcf\x35\x71\xca\x96\xdf\xee\xa0\x9a\x35\x94\x31\x10\x82\xbd\xd0\x4a\x57\x04\xdd\x06\x87\xd2\x26\x1c\x1d\xe1\x31\x03\x30\x56\xaa\x94\x9a\x78\x22\x65\x50\x4f\x79\x69\x53\xd5\xae\xda\x48\x41\x7b\x4e\xc4\x29\xd7\xbe\x96\xa3\x12\x69\xa8\x91\xb5\x8a\x89\x82\xb4\x1c\x93\x72\x2e\x8f\x15\x5e\x11\xab\x9e\xcc\xdc\x37\x1d\x3c\xbb\x6e\x10\x60\xa8\x21\x36\xc4\xba\x43\xad\xd8\x16\x14\x53\xcc\xe6\x8b\xfb\x1f\xb8\xf6\xcd\xba\xcc\x93\xaf\xdd\xf4\xe9\xbc\x5e\x23\x97\x3d\xc6\x15\x25\x5d\xed\x67\x2d\xc5\x41\x8b\xa1\x06\xc4\xb1\x0d\x04\xb1\x81\x10\x1b\x62\xe3\x83\xe8\x7f\xd6\x4f\x9d\x0f\x06\xbc\x9b\x2a\x2c\x1b\x8c\xad\x10\xb5\x7a\x36\xd4\xa4\x0a\x9f\x20\x6a\xcb\xbb\xef\xd4\x35\x04\x03\x41\xcf\xa4\x27\x53\x62\x05\xa1\x71\x3c\xbf\x5e\xfc\xbe\xbc\x3b\x3b\xff\x7a\x79\x71\xfa\x3f\x4a\x3f\xf9\xdc\x13\x70\xa6\x70\xaa\x8c\x98\x89\x08\xf1\x34\xc3\xdb\xbd\xc8\x97\xad\xcc\xf3\x1c\xaf\xaf\x48\xa1\xa5\x63\x3c\x7e\xee\x39\x9e\x19\x83\xe8\xdb\xa0\x09\x85\x75\x34\x79\x91\x4f\xba\x47\x05\x8a\x0d\x26\x63\x07\x01\x23\x9d\x7f\xe0\x21\xa6\xc7\xe1\x50\xee\x54\x4d\xd8\x6e\x0d\x72\xa9\xeb\x7d\xa9\xaf\xec\xf6\xff\x41\x6c\xed\xec\x3b\x74\xed\xec\xb0\x49\xcb\xca\xc1\xd6\xaa\xa4\x6c\x48\xc3\x18\x83\x13\x32\x25\xf5\xef\x37\xa3\x4e\x68\x25\x34\x85\x64\x0b\xab\x55\x9a\x16\xb7\x3f\xdd\xb6\x31\x2a\xd1\xbb\xa6\x5d\x2e\x82\xf7\x69\x7f\x7b\x1b\x0a\x58\x59\x56\xc1\x52\x44\x11\x7c\xbd\x8f\xd2\x90\xba\xc1\x72\x21\xfa\x97\xd3\x5d\xf5\x60\xb8\xe4\x01\x1b\x65\x1b\x83\x5c\x59\xfe\xab\xfa\x21\xcc\xde\xbe\x43\xa8\x93\x85\xcb\x96\xc7\x28\x29\xaa\x3d\x63\xd5\xc1\x50\xa1\x5a\x97\xb2\xf9\xed\x05\x7e\xce\xde\xf2\x98\xfd\xca\xfe\x04\x00\x00\xff\xff\x2a\x35\xc0\x9e\x74\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x54\x4d\x4f\x23\x39\x10\xbd\xf7\xaf\x78\xca\xa2\x70\x59\xb7\x57\x7b\x64\xc5\x21\x24\x24\x8b\x26\x10\x14\x06\x8d\x46\xc3\x08\x1c\xbb\xba\xdb\x8a\xdb\x6e\xd9\xee\x40\x04\xf9\xef\xa3\xfe\x80\x30\x80\xe6\x32\xd2\xdc\xaa\xcb\x55\xf5\xfc\x9e\xeb\xf5\x74\xb9\x38\x47\xee\x8c\xb0\xf9\x91\x30\x95\xb6\x84\xd1\x15\x56\xb5\x36\x8a\x91\xdd\x24\xc9\x5f\xb8\xa2\x88\x7b\xe7\xd7\xda\xe6\x50\xda\x93\x8c\xce\x6f\x91\x39\x8f\x58\x50\x57\x9a\x7c\x59\x2c\x3f\x4d\xce\x96\xe0\xb9\xe3\xc1\x4b\xfe\xf8\x88\x74\xe6\x2e\xd7\x39\x76\xbb\x7e\x46\x5d\x75\xb5\x20\xbb\xd1\xde\xd9\x92\x6c\x4c\x96\xd7\x17\x10\xd5\x1a\x42\x29\x30\x66\x1d\x93\x42\x16\x04\x59\x7b\x83\x5c\x47\x0c\x87\xb8\x49\x00\x74\x99\x22\xc6\x2a\x1c\x71\xee\xc5\x7d\x9a\xeb\x58\xd4\xab\x3a\x90\x97\xce\x46\xb2\x31\x95\xae\xe4\x1d\x13\xae\xa8\xe2\xa5\x08\x91\x3c\xd7\x36\x44\x61\x4c\x1a\x0a\x3c\x21\x14\xcd\x65\xc6\xae\xda\xc2\x59\xb3\x6d\x09\x28\xaa\xc8\x2a\xb2\x72\x8b\x52\x58\x9d\x51\x88\x21\x19\x2f\x2e\xbf\x62\xe6\xaa\x75\x9e\x46\x57\x9a\x3e\x34\x4e\xae\x91\xf2\x66\xc6\x94\xa2\x2c\xf6\xbd\x9a\x42\xcb\x45\x51\x05\xb2\xa1\xf6\x04\xb6\x01\x63\x1b\xb2\xca\x79\xd6\x80\x35\x5d\x27\xad\x00\xef\xba\x32\x6d\x15\xba\x52\xb0\x52\x3c\x28\xaa\x62\x81\x7f\xc1\x4a\x6d\x5f\xe2\xb8\xad\x08\x0a\x8c\x1e\x48\xf6\xa2\x84\x02\x4c\xe2\x70\x3c\x5b\xdc\x9e\x5e\x8c\x4e\xe6\xa7\x93\xe3\x7f\x90\xbb\x5e\xe7\xe6\x02\x46\x65\x46\xe4\x01\x03\x16\xc0\xee\x07\x78\xfd\x2e\xfc\x71\xc7\xd3\x34\xc5\xd3\x13\xa2\xaf\xe9\x10\x37\xff\x35\x77\x1c\x29\x85\xe0\x6a\x2f\x09\x99\x36\xd4\x6b\x91\xf6\xbc\x3b\x06\xae\x8a\xda\xd9\xf0\x37\x42\xb3\x1b\x3a\x16\xb8\x5b\xe9\x28\x9d\xb6\x0f\x1d\xf8\x5d\x32\x5a\xce\x70\x72\x7d\x36\x9f\xdc\x7e\x1e\xcd\xae\xda\xcf\xf9\x64\x3a\x7f\x8e\x67\x8b\xc5\x73\x30\x5a\x8e\xff\xdf\x8f\x16\x56\xa1\x7f\xb3\x56\x9b\x8e\xe9\x2f\x28\xc6\x96\xdf\xc1\x1e\x6b\xf0\x96\x36\x0e\x7a\xe4\x01\x58\xdf\xd9\x2e\xe8\x85\x28\x09\xbb\x9d\x42\xca\x65\xb9\x4f\x35\x99\xe1\xf0\x0f\x00\x4b\xa3\xdf\x41\x4b\xa3\x1b\x2d\xa6\xda\x0a\x03\x5d\x8a\x9c\x92\xd6\xa2\x9d\x37\x8f\x48\xe5\xd4\x9c\x9f\x75\x0a\x41\x0a\x26\xc9\x47\x9d\x69\x29\x22\x85\x37\x7e\xaa\x2b\x25\x22\xbd\x2b\x7a\x31\xab\x77\x2e\xee\x0d\xb1\x21\x8f\x95\xb6\xc2\x6b\x0a\xc8\xbc\x2b\xf7\xfe\x6e\x7f\x05\xed\x1e\x30\xd6\x9c\x1c\xbf\x64\x3f\x74\x3c\xff\x40\x63\x5e\x07\xcf\x57\xda\xfe\x94\xfd\xad\x99\x8d\x7c\x1f\x4d\xed\x25\x5c\xd6\xb6\x25\xa0\x04\x95\xce\x62\xb5\x85\xa2\x4c\xd4\x26\x26\xe3\xf3\x09\xbe\x0d\x5e\x75\xa8\xc1\xf7\xe4\xc7\x00\xdb\xbb\xd5\xcf\x09\x05\x00\x00"),
 		},
 		"/Gopkg.lock": &vfsgen۰CompressedFileInfo{
 			name:             "Gopkg.lock",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 314479185, time.UTC),
+			modTime:          time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 			uncompressedSize: 18602,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x7b\xcb\x72\x63\x3b\x8e\xed\xfc\x7c\x85\xe3\xdc\x41\x4f\x3a\x53\x04\x48\x90\x44\xdf\xe8\xbf\xe8\x3b\xea\xe8\xe8\xc0\x8b\xb6\xca\x7a\x95\xb4\x95\x27\xb3\xbe\xfe\xc6\x96\xd3\x69\xc9\xb2\xd2\x2e\xd7\xc4\x16\xd7\xe6\x7e\x80\x24\x80\x05\x10\xfc\x3f\x77\xff\xf5\xb0\x3c\xdc\x8d\xe5\x2a\xee\x96\x87\x3b\x39\x4e\xdb\xfb\xd8\xc4\x5e\xa6\xf0\x7f\xbf\xf3\xed\xdd\x66\x3b\xdd\x85\x2f\xa7\xff\x7b\x67\x0f\xb2\xb9\x8f\xc3\xdd\x5a\x7e\xdc\x69\xdc\x1d\x37\xbe\xdd\xc4\x9d\xfe\xb8\x9b\x1e\xe2\x6e\x13\xdf\xa7\xbb\x7f\xf3\xd8\xdd\xc5\xe6\x70\xdc\xc7\xbf\x7d\xfd\xe3\x8f\x3f\xfe\xfb\xbf\x77\xfb\xed\xdf\xc2\xa6\xc3\xff\xfc\xcf\x1f\x77\x77\xba\x97\x8d\x3d\xdc\xfd\xe7\xdd\x9f\x6b\x39\x4c\xb1\xff\xf3\x8f\xbb\x3b\x5f\xde\xc7\x61\x9a\x31\xf8\x8f\xc4\xd2\x46\x2b\xa1\x55\x35\x5b\x1a\x50\xbc\x33\xd6\x0a\xc9\x7a\x1b\x54\xd9\xc8\xc6\xa8\x3d\xb8\x75\xcf\x29\x58\x32\x95\x24\xa1\x15\xeb\xf0\x91\xe6\xe7\x6d\x64\x1d\xf3\xd3\xee\x97\xd3\xc3\x51\xbf\xda\x76\xbd\x50\xd9\x4f\xf1\xb8\x59\xdc\x6f\xbf\xe8\x72\x97\x79\xee\xb6\x13\x7b\x94\x59\x98\xff\xbc\xfb\xef\x3f\xbf\xfe\x39\x7f\xdd\x6e\x7f\xdc\xc4\x76\x37\xcd\xd8\x9f\xff\xef\xbf\xe6\x5e\xfb\xf8\xb6\x3c\x2c\xb7\x9b\x19\x91\x44\x5c\x5b\x48\x62\xf2\x0e\xd6\x47\x94\x9e\x73\x6b\xb5\xb5\x62\x43\xc6\xe8\x41\x29\x57\xfb\xf3\x9f\x16\xdb\xab\x0c\x89\x70\xa0\x84\x22\xd8\xa3\x27\x29\xe1\x89\x3b\x38\xb5\x24\xce\xa0\x48\x8d\x81\x73\x49\x05\xa9\x5a\xd4\x86\xe1\x49\x6a\xe2\x48\x7e\x4b\xec\xd8\xee\x37\x6d\xb1\x8b\xfd\xe3\xe1\x4a\xe4\xbf\x1f\x65\x33\x2d\x57\xf1\x11\xc9\xb3\xb4\x06\xce\x8c\xdc\xf2\xc0\x22\xd2\x90\x3c\xb5\x5e\xbb\x96\xda\x1c\xdc\x87\x85\x0c\xbd\x96\xfc\x5c\x4a\xc8\x25\x0b\x72\xcd\xa5\x83\xe4\x44\x26\xc5\x13\x41\xf4\xa2\x86\xa2\x0e\x55\x6b\x82\x08\xc4\xf0\x8c\x65\x74\xaf\x89\x3c\x40\x5c\x91\x41\x6f\x4e\xee\x7d\x6c\xa6\xfd\x8f\xc5\x61\x17\xf2\x18\x72\xf8\xf1\xb9\xd9\x2d\x22\x6a\x58\x7a\xe9\x16\x34\x3c\x03\x23\x8f\xe6\x10\x25\xa4\x15\xcf\x2d\xb1\x41\xb1\xd3\x58\x7f\x8b\xfd\xf3\x6d\xdf\xd2\x57\xf8\x9a\xfe\xf9\x29\xb7\xe4\x61\xa3\x66\xec\x25\x63\x2a\x8e\xda\x5b\x07\x8f\xa6\x58\xa3\xa5\xdc\xa9\x97\x40\x37\x33\x6b\x81\xa3\x24\x88\xde\x25\x3a\x29\xf8\x40\x6d\xb7\x06\x63\xb2\xc3\x71\x39\xc5\xfc\xc3\xaf\x06\x42\x27\x0b\xfb\xc8\x60\xd4\x16\xd4\xb2\x23\x80\x58\x10\x97\x01\xb9\x56\x2d\x16\xec\x99\x1b\x56\x2b\x0a\xac\xfe\x86\xdc\xe7\x32\xe6\x5e\x3d\x80\xda\x3c\x8c\x8c\x34\xeb\x36\x5b\x87\x81\xd5\x22\x01\x20\x66\x8b\x34\x12\xa7\x4c\xd9\x00\x08\xc7\xf0\xd1\x72\xf2\xe6\x90\xc4\xf0\x03\x32\x1e\xa7\xe5\xea\x5a\xcc\xb0\x87\x8c\x1f\x91\xd3\x8b\x59\x6f\xda\x6b\x82\x5a\x3d\x25\xaf\x4a\x3a\x6b\x54\xd6\xec\x0d\xbc\xa6\xde\xbd\x78\xf9\xdd\xfc\x7a\x7c\x8b\xd5\x76\xf7\x7a\x82\xb5\x91\x56\x83\x9c\x5b\x0e\xc1\x51\x1b\xb5\xda\xb0\x5b\x35\x42\xa5\x56\x90\x87\x04\x41\xe7\x54\x5b\x55\x62\x77\xa7\x24\x91\x59\x3d\xab\xc0\x2d\xe1\x6d\x7b\x58\x6f\x0f\x3f\xff\x7d\x39\xf8\xe3\x6b\xe9\xff\xb8\xbb\xbb\xbb\xfb\x53\xe5\x10\xb2\xdb\xfd\xf9\xef\x4f\x4d\x5b\x2d\x63\x33\x5d\xb6\x16\xb6\xdd\x4c\xf1\xfd\x35\xfa\x18\x3f\x0e\xaf\xa0\xfd\xce\x5e\x21\xd3\xf7\x57\xc0\x3c\x0f\x2f\xb7\xad\x7d\x71\x2f\x4b\x59\x2c\x37\xcb\x97\xc7\x6f\x3d\x5e\x1e\xb3\xff\xb1\x9b\xb6\x97\xad\xcb\x37\xbf\x40\x8b\x07\x7f\x0b\x5d\x2f\x37\xd3\x63\xfc\x78\xbe\x74\x88\xfd\xb7\xd8\x5f\xb6\x66\x09\xc7\xf2\xfe\x17\x38\x6d\xf7\xf1\xdc\x98\x7e\xec\xe2\xd7\xdb\x7e\xea\xf3\x73\xf3\xfb\x42\x8e\xd3\xc3\x65\x6b\xf1\x3c\x68\xab\xe5\xdb\x17\xa6\xef\x7a\x5c\xae\xfc\xe5\x1b\xbe\x2f\x54\x36\x8f\xa7\xd6\x07\x56\x22\x25\xb2\x4c\x75\x60\xf2\xe4\x3d\x4c\x23\x9b\x49\x88\x4a\x1b\x35\x8f\x24\x09\xba\x49\xee\xbf\xd7\xb8\xe8\x92\x89\x66\x67\xd9\x6b\x86\x5a\x1b\x8d\x41\x45\xbc\x8e\xc4\x8e\x95\x1c\x90\x8d\x9d\x7b\x65\x60\x19\x6d\x50\x01\x19\x2a\x94\xbc\x37\x8b\x77\x16\xdd\xbf\xe6\x3e\x09\x81\x7a\x94\xca\x4d\x7b\xf3\x80\x1a\x9e\x39\x05\xa8\xcb\xe8\x90\x83\x3a\xa4\xd4\x87\xfc\x5e\xc2\x31\x82\x3b\x16\x47\x2e\x2e\x29\x6b\x66\x18\xa5\x04\x48\x74\xec\x80\x1d\xb4\xc8\xb0\xf9\xa1\xc2\xd4\xbb\x71\xe2\xd6\x3a\x45\x96\x01\x69\xb6\x7e\x6f\x4b\xe8\xf2\x2d\xec\xfe\x61\x16\xf1\xb0\x8b\xbf\xae\x24\x3c\x81\x1f\x10\xb2\x33\x83\x1a\xb2\x08\x54\xa3\xd2\x6d\x9e\x8d\x36\x46\xeb\x58\x53\x60\x53\x16\x6d\xd6\xf2\x6b\x2f\x02\x5f\xe1\x2b\xfc\x5e\x72\x6b\xb5\x14\x6b\x59\xb2\x63\x6e\x05\x86\xab\x74\x61\x56\x28\xb5\x44\x42\x10\xc4\xa2\x2d\x30\x91\x46\xe1\x86\x0d\xa4\xa7\x94\x05\x66\x4f\x0b\x7a\x43\xf2\xd0\xa3\x3d\xac\x65\xb3\x18\xb2\x5c\x7d\x99\xe2\x30\x7d\x6e\x7a\x99\x46\x4f\x0c\xa9\x21\x92\x06\xa4\x1e\xc3\x06\x24\xc9\x94\x78\xf6\xa1\xd5\x22\x46\xb6\x37\x4c\xe9\xb9\x90\xa2\xa1\xb9\x8b\x47\x1e\x19\x85\x91\x4b\x0e\xd2\xa0\x32\x88\xc2\xab\xd7\xc8\x5a\x6b\xc2\x56\xc1\x5b\xd1\xd9\x69\x17\x63\xf7\x42\x06\x5d\xf4\x16\x13\x1a\x87\xcd\x76\x5a\x8e\x1f\xbf\x7e\x7c\x4e\x46\xc3\x8e\x1d\x53\x36\x6f\x69\xd6\x19\x33\x1b\x3a\x7f\xcb\xe8\x0a\xa3\xcf\x12\xb2\xf4\xe0\xeb\xd9\x2d\x5f\xdb\x3b\xeb\xda\x07\x41\xe5\xd4\xf2\xd0\x24\x5e\xdd\x00\xa5\x25\xc3\xc2\x50\x28\x72\x1a\x25\x51\xaf\xa5\x69\x08\xd2\x48\xa2\x5e\xba\x56\xe7\x81\xd8\x05\xe0\x86\xe0\xf7\xdb\x2f\x8f\xcb\x69\xf1\xb8\xbc\x9a\xd5\x27\x23\xb5\xda\xfe\x32\x8f\xab\xed\xfd\x62\x35\xfb\xb2\x73\x60\x8a\xfd\xfa\xb9\xbd\x8e\x69\xbf\xb4\xc3\xab\xe6\xc2\x97\x07\x93\xbd\xbf\x86\x97\x9b\x29\xf6\x1b\x59\x2d\x56\xdf\x5e\x5f\xda\xed\xb7\xeb\x98\x1e\xe2\x78\xf8\xa8\x75\x2c\x6e\x4d\x83\x1c\x1d\xb0\x77\x68\x99\xb0\x67\x35\xd1\xf9\x17\xb4\x1e\xc0\x69\x58\x83\x6b\x72\x56\xdf\x22\x67\x17\x24\x05\x04\xba\x4b\x60\x93\x59\x6d\x53\x2b\x04\x14\x25\x4b\x29\x59\x74\x38\xaa\x54\x0f\xad\x5e\x39\x27\xf6\xee\xcd\xa2\x75\xb6\x42\x23\x97\x4a\x7c\x7b\xe0\x57\xdb\xfb\xb1\x9e\x16\x4f\xff\x3e\xb7\xe0\x32\x27\xd1\xc6\x99\x22\xb0\x87\x55\xc5\x5e\x73\x2d\xaa\xc2\x5a\xdc\x6b\x81\x64\x9a\x9d\xae\xe5\xce\xef\xc9\x4d\xbd\x86\xb4\xea\xea\x29\xb7\xe2\x75\x68\x2d\x2c\x0c\xde\x92\x57\x42\x6d\x23\x92\xd9\x18\xda\x19\x92\xb4\x56\x6a\x8f\xd6\x12\x46\xe3\x04\x23\xdf\xd2\xb4\xd9\x80\x4e\x62\x8f\x8b\xd3\xdf\xcf\x49\x8d\x23\xa2\xca\x00\xe1\xc6\x24\x32\x34\x12\x52\x96\x64\x43\x7d\xd4\xda\x03\x86\x76\x61\xb9\x56\xb3\xfe\xee\x6c\x53\x45\x18\x81\x73\x68\x99\x46\x22\x49\x56\x62\xd4\x8a\x86\xd5\x92\x68\x89\xa0\xa4\x26\xad\x8b\xe4\x34\xa2\x37\xcf\x19\x30\xa9\x63\x87\x4a\x71\x53\xea\xfb\xed\xbc\xac\xa7\xad\x1e\xc7\xdb\x9a\x36\x77\x39\xf5\x78\xd6\x86\xbf\x1d\xb6\x9b\x9d\x3e\xb7\x2e\x2e\x9d\x1a\xf6\xe5\x3e\x36\x5f\x4e\x4f\xf6\x38\xd8\x7e\xb9\x9b\xb6\x2f\x04\x67\xbb\x9f\xce\xf9\xd2\x0b\x9d\xf9\x08\xbb\xcf\x55\x47\xca\x09\xd5\x98\xa8\x91\x57\x2e\x65\x8e\x30\x05\x6b\xca\x40\x75\x0c\x77\x1f\x57\xda\xf4\x01\x27\x05\x6d\x04\xd6\x12\xc1\xa9\xcb\xb0\xc6\xd4\x72\x89\x6e\x25\x6a\x76\x45\x31\x13\x95\x41\x2d\x63\x75\x1d\x08\xad\x66\x69\x5e\x67\x06\xce\x15\xeb\xad\x18\xef\x7e\xbb\x92\xcd\xfd\x3b\x23\x7c\x39\x84\x17\x04\xef\xa9\xb5\x90\xcd\x8f\x57\x88\x1f\xf7\x32\x9d\x51\xbf\x9f\xf0\xb4\x5c\xc7\x61\x92\xf5\xee\xa3\x43\xaa\xc5\xc3\x25\xcd\xf1\xb1\x16\x6b\x49\x29\x39\x62\x55\x90\x51\x78\x64\x17\xe2\x41\x58\xaf\x14\x15\x3e\x17\x3d\x16\x49\x56\x55\x4b\x4f\x44\xa9\x4b\xc5\xde\x66\xae\x27\xd6\x5b\xd5\x40\x31\x80\x8e\x94\xd9\xa4\x4b\xc6\x52\xbb\xcf\xdc\xa8\x77\x0c\x76\x4a\xe9\xb6\xd1\x3a\x0d\xf3\x61\x23\xbb\xdd\x27\x5d\x24\x46\xa5\xd1\x09\x89\x5c\x2d\xa7\x86\xe1\x03\xfb\x29\xae\x9a\x19\x41\x69\xc8\x8d\x07\xbd\xc3\xf2\xac\xf1\xd0\x04\x49\x23\x77\x21\xf6\x4a\xcd\x4a\xb7\xaa\x02\x3e\x53\x9a\x2e\x52\x09\x86\x50\x55\x6a\xec\x4c\xec\xad\x50\xcb\xda\x7c\x8c\x1e\xb7\xbd\xe1\x7e\xb9\x5a\xc9\xaf\xc8\xe7\x53\x12\xa6\xae\x34\x0a\x16\x65\x6c\x10\xe1\xa3\x0e\x1e\xc9\xa2\x57\x53\xce\x3c\xd3\xda\xdc\xa4\xe0\x27\xb4\x27\x5a\x1e\xa4\x09\x08\x21\xd1\x80\xae\x06\x19\xc6\x1c\x0d\x36\x67\x2e\x9c\x13\x59\xaf\x9c\x47\x97\x56\x91\x7a\x97\x8e\x52\xfa\xa8\xd0\xa8\x0e\x1a\xef\x88\xbd\x3e\x7e\xff\x9c\xc8\x91\x5b\x42\x0d\xc7\x36\x92\x67\x6e\xad\x69\xd2\xdc\xb4\xd6\xa2\x15\x7b\x8a\x1e\xa3\x0f\x1d\xd7\x22\xd7\xaf\xf8\x7b\x91\x4b\x76\x4f\x5d\x20\x75\x2a\x8a\x89\x6a\x54\x20\x07\x05\x47\x14\xe3\x42\xc4\xde\x11\x63\xa6\x58\x75\x98\x31\x1a\x08\x24\x6a\xd1\x09\x7a\xbf\x6d\x90\x9f\x44\xfe\x2b\xf4\xb0\xb5\xc7\xf8\xe4\x5c\x87\x14\x87\x51\x3b\xa8\xa8\x5a\x30\x15\x32\x36\x1a\xd9\x09\xb8\xc8\xcc\x03\x3a\x0f\xd2\x6b\xc1\xf1\x3d\x4f\x64\xc9\x81\x45\x6b\xd1\x8c\x16\x3c\x82\x6c\x14\x77\x0b\x95\xd6\x9d\xd4\xb8\xa7\x36\x52\x82\xea\x5a\x15\xa0\x67\x62\x76\xc9\x6e\x86\xc5\xe1\x96\xa5\x7c\x90\xc3\xc3\xd2\xb6\xfb\xdd\xe2\xc1\xae\x32\x23\x4f\x36\xee\xeb\xb3\xb1\x7b\xb0\xd5\x42\x0e\xd3\x79\x73\x27\xfb\xc3\x4b\x08\x7b\x42\xf6\x27\x26\x77\x0e\x1d\x4c\x36\x9b\x57\xd0\xb4\xb7\xed\xe6\xdb\x39\x34\x6d\x1f\x63\x73\xee\xec\x5e\x3d\xfc\x04\xbd\x7a\xd4\x09\x7b\xb9\xf1\x23\x01\x97\x69\x0d\x52\x26\xc6\x0c\x66\x00\xc0\x51\x32\x12\x5b\x91\x9a\xfa\x60\x23\x10\x2c\x6f\xf8\xb2\xf4\xde\x0c\xf5\x96\xbc\x94\x99\x2d\x40\xd3\x1e\xb5\xb3\x37\x2e\x3c\x62\xd4\x28\x39\x86\x5a\xeb\x2d\x28\x0d\x4c\xc9\x14\xa2\x25\x57\x13\xc6\x54\x04\xbc\xea\xad\xa5\xb9\xdc\xd8\x76\x73\x78\xd8\xc7\xb7\x10\x5d\xc5\x62\xbd\x3d\x1e\x62\xda\xcb\xee\x73\x4b\xb4\xd5\x8a\x55\x82\x8d\xc1\xca\x40\x81\x34\x72\x31\xf1\x6e\xd1\x73\x48\x41\xe3\xac\xda\xde\xf0\x3c\x9f\xf0\x3b\x99\x15\x9b\x43\x3e\x25\x70\x0b\xc2\xe0\x0e\x99\x6b\x13\xea\xb5\x0e\x95\x4c\x6e\xe0\xc5\x47\xc9\x52\x23\x46\x64\x6d\x42\xa5\x14\xd3\xd4\x10\xca\x8d\x21\xf9\xdb\xfa\x61\xeb\xf7\x71\x98\xe3\x8f\xe5\xfd\xf6\x93\xc1\x59\x41\xe7\x48\x26\x09\x73\x20\x70\x07\x4c\xc0\x75\xf4\x82\x2d\x81\x6a\x31\x22\x6f\xca\xff\xbc\xd4\x52\x4b\x64\xcc\x6e\xa9\x6a\xcb\x9d\x31\x48\x95\x3c\x95\x64\xe1\xa5\x91\x95\x5a\xc8\x0b\xd5\x94\x7b\xce\xb9\xf7\x3c\xa8\x73\x2e\xa2\x3a\xea\x68\xb7\xb2\x2a\x8f\xfb\x7f\x29\x36\xd0\x5e\x22\x27\x31\x27\x20\x11\xb7\xa2\xad\x67\xf1\x32\x46\xcf\x32\x46\x46\x66\xf5\x11\xef\x99\x21\xaa\xdd\x5b\xc3\x26\x82\x15\x2d\xa3\xfa\xe8\x92\x47\x73\xed\xb9\x42\x19\x4d\x46\x0a\xaf\x15\x4a\x2b\x8a\x85\x7a\xf7\xa8\x99\x0c\x53\xc2\x62\xb7\xf2\xd0\x6b\xb9\x5f\xda\x76\x23\xcb\xfd\x4c\xda\x76\xb1\x9f\x96\x71\xbd\x07\xf1\xc1\xa0\x3b\x53\xce\x15\x4f\x3b\x21\x4d\x87\x20\x76\x28\x6c\x15\x7b\x99\x67\x18\x13\xd8\xd0\xeb\xd8\xef\x03\xd1\x40\xe2\x0e\x94\x70\x5e\xc5\x15\x20\x1b\x5b\xb7\x22\x96\x13\x50\xcf\xbd\x40\x27\xb2\x3e\x9b\x65\xe9\xd6\x70\x54\xae\x9a\x43\x67\x97\xef\x29\x4a\xd0\x4d\xe1\xa7\xe9\xb4\xd9\xb4\x3c\xc8\x34\x7d\x92\x48\x55\x93\xe2\x3a\xa8\x78\xee\x11\x02\xc2\x8c\x9a\xad\x21\x4a\xab\x42\x0e\x56\x4c\x91\xec\x3a\xf4\x4b\x5f\xdf\x49\xb2\x8c\x41\xa1\x12\xb9\xd8\xd0\x91\x4a\x73\x4a\x14\x01\x94\x3c\xb0\x45\x4d\xd4\x52\x74\xcb\x5c\x34\x6b\x1f\xae\xda\x30\x8d\x51\xc5\x1a\x70\xa7\x71\x2b\x87\x36\x8b\x3d\xed\xf6\xdb\xa3\xff\x24\x92\xff\xfb\xcc\xd7\xff\x37\xbe\x4f\xb1\x99\x3f\xf1\x7a\x0d\xec\xf4\x94\xc3\xff\xc8\x42\x00\xcc\xa5\x5b\x60\xf7\x28\x29\xc2\x13\xe4\x2a\x82\x5a\x4b\xf1\x14\x91\x2a\xa5\xa0\x7a\x35\x22\xb3\xa9\x7f\x87\x78\xb5\xae\xaa\xa0\x42\xda\x2c\x0f\x0c\x4f\x21\x10\xa2\xd4\xd4\x3d\x77\x62\x09\x6b\x01\xad\x75\x00\xaa\x39\xdc\x0a\x00\xcf\x2c\x2c\x69\xaa\x72\x8b\x78\xad\x97\x93\x3d\xc4\x6a\x75\xca\x2b\x3e\x6c\xd7\xe1\xcb\xfd\x27\xf7\x1e\x03\x66\x12\xdd\x35\x63\x22\xa5\x0a\xd6\x38\x7a\xd0\xa8\xac\x63\xcc\x71\x47\x85\x4e\xa3\x7c\xc2\xcd\x51\x56\x2b\xe6\x85\xa1\x98\x37\x73\x42\xc8\xcc\x9c\x9c\xa0\x25\xaf\x6e\xcc\xa9\x36\x89\x0c\x46\x95\x72\xaa\x08\x0a\x5d\x33\x0d\xcb\x29\x43\x7f\x57\xf6\xb5\xec\x0e\xd3\xfe\x68\xd3\x71\x1f\x9f\x4c\x83\x50\xae\xc2\xc8\xe1\xee\xca\x42\x9a\x8b\x7a\xed\x15\xdc\x8a\x70\x2d\xcd\xb4\x10\x8f\x78\x8b\x72\xbf\xc3\x3f\x99\x5a\x01\x8f\x2c\x03\x6b\x12\x46\x33\xb2\x36\xf2\xc8\xa9\x42\x74\xc2\x99\x91\x4b\x07\x25\x57\x4c\x7e\x7a\xad\x4b\x2b\x3a\x0f\x53\x6d\x37\x13\x02\xbb\x58\xad\x62\x5a\xc6\x7e\x9e\xf9\x69\xbb\xbe\xde\xa4\xfa\x98\xed\x4b\xe0\x80\x2d\x8d\x91\xa3\x14\x94\x2e\xd4\xcc\xdd\xc0\x18\xdd\x00\xe6\xa5\xd9\x81\xdf\x98\xf6\x77\xf9\x67\x49\x01\x4c\x0c\x4d\x98\x40\xba\x8e\x5e\x9b\x79\x22\x0f\x94\x52\x45\x06\xf4\x54\x8d\x92\x0d\xc6\x08\x1d\xc5\xa0\x8e\xd6\xcd\x67\x9f\xda\xca\x2d\xc3\xbf\x7b\xbc\x5f\xc4\x7e\xbf\xdd\x7f\xd2\xda\xd7\x42\x31\x52\x2a\xc4\xe1\xbd\x08\x00\x03\x37\x1d\xda\xbd\x63\xa2\x54\xd0\xaa\x8f\x9a\xdf\xd8\x86\x7d\xdf\xda\x27\xec\xa6\xc0\x8a\x51\x2c\x03\x20\x22\x99\xf7\x06\xbd\xa5\x81\xce\x36\x0a\x2b\x6b\xc1\x56\x29\xc3\x48\xb9\xe4\x2e\xbd\x07\x17\x8d\x5e\xc7\xcd\xa9\x5e\xc7\x3f\x64\x3f\xdb\xbc\x2f\xbe\x1c\x63\xb5\xd4\x2b\xc9\x9f\xf1\x8f\xd0\x39\xc6\xd6\xab\xb5\x92\x92\x40\xae\xd8\xd1\xa0\xd6\x52\x2b\x49\x24\xe9\xae\x8c\x60\xd5\xde\x88\x2e\xdf\x55\x74\x03\x49\xf3\x83\x06\x57\xc8\x91\x3a\x06\x60\x62\x1b\xd8\xbb\x8e\x5a\x46\x49\xb5\xbb\x79\xb5\xde\xa4\x16\x1d\x33\x9f\x1c\x29\x55\xf1\x82\xa8\x72\x2b\xe2\x78\xc9\xe6\xfe\xdc\x0a\xfb\xdf\x27\xeb\x7f\x33\x49\x73\x9e\xfb\xbd\x40\x4e\x8f\x7a\x98\xa6\x0f\x67\x5d\x24\x70\x76\x49\xe6\xce\x49\x07\xa0\x39\xe4\x22\xde\xd8\x21\xd7\x2a\x75\x9e\xd1\x51\xf3\x1b\x26\xe0\x3d\xca\x87\x4e\xe6\x15\x5c\x84\xa8\x12\xf4\x16\xe0\x5c\x55\xa2\x16\x57\xb5\x9a\x7a\x72\xb1\xd1\x0a\x94\xd2\x83\x2b\xb2\xd5\x32\x9c\x33\xa6\xac\xc9\x6f\x11\xdd\xeb\xb1\x5a\x6f\x3d\xae\x8d\xc3\xfd\xf6\x43\x3b\x6a\x96\x7b\x03\xef\xcc\x90\x74\x68\xc6\x41\xc3\x44\x51\xa4\x28\x87\xd5\x1e\x95\x84\x79\x7c\xaa\x3a\x21\xb3\x24\x13\x07\xce\x62\x85\xb5\x06\x16\x82\x1a\x69\x30\xcf\x3c\x10\xbc\x19\x36\x28\x18\x24\xd4\xa3\xab\x43\xf6\x18\x8a\xce\x62\xb7\x7c\xc2\xb9\xf8\xdb\xf5\x7a\xbb\x79\x7b\x8d\xc4\xf7\xdd\xcc\x8a\x7f\xae\x8f\x5f\x1b\x09\xba\x9c\xf4\x38\xc7\xf2\x5f\xb7\xfb\xfb\xc5\x5f\x7f\x2d\xee\xb7\x72\x9c\xb6\x9b\xf8\xb5\x85\xf1\x34\x98\x1f\x4d\xd9\x99\xb6\xe2\x91\xba\xe7\x26\x05\x9a\x69\x6d\x9d\x23\x0c\x66\x15\x80\x94\x4a\x9a\xc9\xd1\x27\xa2\xa4\x18\x6d\xf6\xa6\x0c\x38\x98\xad\x71\xc9\xc5\xd9\x12\x57\xea\xd8\x4a\x6d\x5d\xad\x53\xea\x29\x34\xb2\x68\x46\x8d\x99\x6c\xa5\x50\x0b\x0a\xb8\xc9\xaf\x2e\x15\xc5\xc6\x95\x85\x7d\x15\xde\xff\x1a\xb8\x13\xc1\xfa\x09\x6e\xc6\x2f\xc5\xfb\x3e\x3e\x9c\x31\x86\x4e\x5a\xb0\xf7\x02\xd9\x51\x92\x7b\xea\xe9\xe4\x0d\x38\xb9\xc7\xac\x83\xc8\x41\x12\xef\x18\xa0\x42\x54\xa5\x94\xc8\x94\x94\x92\x14\x4e\x54\x8a\xb3\xa6\x1a\x3c\x54\xd8\xb0\x57\xc3\x79\xfc\x53\x94\x36\xa8\x8c\x2c\x8c\x50\x88\x1b\x72\xbf\x35\x2e\x7b\xdb\x6f\xff\x5a\xc5\x8f\xd9\x02\x3f\xef\x51\x7d\x2e\xdb\x83\x2d\x95\x80\x4a\x50\x29\xe6\x68\x31\x55\x1c\xc4\xc0\x5a\x14\xb9\x70\x09\x1e\xd2\xf8\x1d\x33\x5b\x65\x76\x5b\x2a\xad\x16\xa1\xea\xd8\xa8\x77\x9e\xd5\x71\xe4\x5e\xba\x63\xa9\xdc\xbd\xf4\x52\x70\x9e\xf0\x4e\x11\x4d\xf2\x53\x25\x10\x61\xb5\x5b\x1b\x2b\x87\xdd\x80\xbc\x90\x11\xfb\xab\xf8\xf8\xd5\xbc\xaf\x63\xfd\xd1\x79\xf5\x92\x3a\x81\x89\x24\x6f\xa5\x65\xce\x2e\x30\x86\x62\x1f\x6d\xb0\x77\x2d\x11\x63\x84\x5e\x79\xdb\x0f\x10\xab\xd9\x58\x34\xd0\xf0\x46\x05\x6b\xe7\xdc\x06\x35\x2f\x31\x7b\xe0\x14\x09\x98\x66\x79\x73\xae\x43\x5a\x76\x15\xeb\x3d\x75\x2e\x50\x4b\x30\x18\xdc\xa2\xd4\x4f\xc3\x60\xf2\xd9\x6d\xea\xce\x95\x72\x26\xed\xd6\x20\xb5\x8c\x80\xd8\x23\x9f\x12\x9c\x26\xca\x3d\x63\x23\x50\x79\x23\x88\x78\x97\x51\xb5\x31\x2c\x71\xcf\x29\x93\x5a\x0b\xe4\xe6\x92\x6b\xef\xce\x23\x80\xbd\x26\x29\x98\x82\x2d\x77\x8d\x81\x79\xf4\x42\x46\xb9\xf5\x1e\x5e\x25\xdd\xa4\x92\x3f\x25\xde\xea\x5e\x3e\x99\x21\x52\x34\x12\xe3\x61\xa9\x0c\xa3\x18\x32\xb4\xa6\x96\x52\x83\xec\x65\x48\x4d\xac\xad\xb5\xab\x24\x66\x7a\x3f\x6e\xaa\x3d\xa4\x04\xe6\x06\x99\x3b\xbb\x61\x52\x50\x0f\x27\x67\x17\x34\x1a\xac\x01\x65\x0c\xee\x9d\x34\x62\x94\x0e\xbd\xf4\x70\x87\x6e\x58\xed\x56\x4d\xc2\x93\xc8\x7f\xfb\x4b\x56\x53\xec\xff\x0a\x99\x1e\x62\xbf\x96\x2b\x6f\xf1\xd1\xc2\xbe\x52\x52\x8d\xd2\xba\x49\x45\x4e\xa7\x7d\xcc\xda\x87\xe5\x3c\xf2\x48\xa5\x40\xcb\x96\xa4\x7e\x8a\x51\x29\x40\x42\x2c\x10\x6d\xd4\x42\x6a\x3d\x92\x21\x4a\x64\xc2\xe8\x23\xb9\xd5\xd2\x8b\x56\xd3\xe2\x90\x71\x08\x0f\x2c\xd0\x4a\x24\x00\xbe\x59\x6e\xf3\x24\xfe\x6e\xac\xe4\x8a\x44\x7d\x70\x13\x86\x3b\x74\x1c\xb5\x5b\xad\x96\x08\x91\x43\x53\x16\x83\x06\xa2\x51\x23\x27\x8e\x68\x6f\x6d\xa0\xa6\xaf\xf9\x9d\xdc\x41\x0f\x90\xda\xba\x20\x35\x08\xac\x34\x8a\x0e\x06\xc9\x41\x91\x51\xa4\x2a\x94\x56\x84\xc8\x34\x85\xf4\xc2\x8d\x92\x19\xb4\xd3\x66\x81\x73\xfd\xad\xc8\xdf\x96\xbb\xf8\x64\x80\x8c\xa4\x39\x89\xa4\x9a\x87\x41\x8f\xd2\x6b\x45\xed\x95\xb9\x22\x61\x88\xba\x0d\x1c\xe9\x29\x5f\xf5\x4f\xce\x72\x8b\xee\x58\x1b\xa7\x64\x6d\x48\x1b\x99\x00\x59\x50\x72\x23\xae\x91\x7b\x78\x1a\x00\x26\xad\x54\xaf\xce\x2a\x0d\x1b\xf7\x14\x01\xb9\x0f\x1e\x37\x45\x9e\xf6\x31\xd9\xc3\x7e\x31\xc5\xe1\xad\x92\x94\x27\x63\x2e\x87\x43\xec\x7f\x71\xa1\x7d\xfc\xfd\xb8\xfc\x59\xc4\xf6\x11\xb7\x8d\x5a\x47\xcb\x51\x53\x2f\x2e\x9e\xba\x34\xab\x41\x8d\xb0\x17\x85\xd6\xc2\x64\xe8\x1b\x65\x13\xb3\xb1\x7b\x47\xf3\x35\xdb\x53\x50\xa6\x50\x13\x48\x2f\x75\xa6\x50\x79\x64\xb0\x94\xa5\x71\x85\x5e\xd1\x14\x8d\xbb\xdb\x48\x4c\x6c\xa5\xe5\x5e\x72\x54\x67\xc1\x9b\x9a\xff\x63\xe3\xd3\x1c\x34\x9f\x2a\x51\xfc\x2a\x94\xfa\x59\x99\xf2\xf3\xe2\xbf\x5f\x34\x17\x26\xf6\x10\x57\xe0\x76\xbd\x93\xfd\xcb\x16\xc3\x33\xfe\x33\x48\x7d\x85\x8e\xe5\x6a\xba\xee\xbb\x9c\x62\x2f\x67\xbb\xef\xcf\xf8\xdf\xb6\xc7\x99\x60\xbd\x86\xd7\xb1\xbe\xfe\xb8\xed\x6e\x7a\x0d\x1d\xa6\xed\x5e\xee\xaf\xbe\x78\x12\x5d\x5d\x81\xbf\x38\xdc\x87\x32\xe1\x56\x67\x16\x13\x1c\xb9\x0d\x01\x68\x83\xb2\x91\x70\xaa\x9e\xb5\xe6\xc4\xc9\x7b\x29\xf4\x8e\x6d\x4f\xa4\x95\x4a\x1d\x79\x94\xdc\x0a\x55\x26\xe4\x1e\x86\x9e\x0b\x7a\x0e\x26\xd4\xea\x0c\xe6\x83\x47\x2e\xac\x21\x9c\x81\x46\x6d\xcd\x1b\xf1\x2d\x07\x3e\xc5\xc6\x63\xbf\x5e\x6e\xa6\x7f\xb1\x46\x39\xa8\x97\xc4\x85\xc7\x28\x63\x8c\x64\x54\x07\x6b\x15\x2a\x10\x88\x5a\x33\x75\x08\x61\xff\x44\xf4\x93\x7a\x13\x91\xc6\x98\x82\x3c\xe9\x68\x4c\x73\xfc\xaf\xd4\x2c\x12\x58\x26\x9b\x9d\x3c\x57\xd1\x92\x79\xd6\x75\x00\x8c\x2e\x12\xdd\x4a\x6b\x78\xcb\xac\x9f\x49\x1e\x8e\x44\x70\x55\x48\xf9\x8a\xc8\x85\xff\x25\x7b\x3f\x3c\x75\x7d\xc6\xbe\x4f\x7b\x79\x41\x3e\xc2\xf1\x7a\xee\x2d\x21\x39\x2a\x3b\x50\xb7\x51\x62\x68\x6a\xd1\x42\x47\x87\xa2\x66\x81\x89\xde\xa9\x4f\x43\xe3\x06\x52\xc8\x3a\x9b\xa0\x99\xb5\x4c\x32\x28\x31\xb0\x79\x44\xa2\xa1\x6e\x54\xbc\xe8\xa0\x54\x91\x52\xcb\x95\x73\x4e\x29\x32\x84\xf8\xad\x88\xf0\x6c\x4c\xee\xb7\x5f\x64\xbd\xdc\x7c\x72\xf7\x67\x88\xd4\x68\x19\xb8\x94\x40\x6d\x5a\xa5\x54\x71\x4c\xc8\x09\x3b\x41\xf4\x99\x6a\x6b\x5c\xa5\x08\xd3\x57\x78\x97\xd8\x51\xce\xdc\x12\x77\xaf\x62\xda\x2a\xe4\x4c\xbd\xf6\x6c\xd6\x8b\x04\x31\x76\x90\x9a\xac\xa6\x9c\x47\xd2\x31\x2a\x0e\xe9\x9e\x07\x36\xb6\x6a\x25\xdf\x4a\x9c\x9c\xc9\xbe\x94\x6f\x9f\xcc\x10\x66\x31\x63\x18\xda\x3b\x80\xcf\x44\xaf\x24\x96\x4e\x24\x01\xa3\xc7\x69\xfb\x92\x7b\xe0\x5b\xc7\x16\xde\xdc\x01\xbc\xdc\xf7\xe2\x08\x75\x20\x4d\x94\x0a\x8d\xe1\x0a\x49\xb8\x97\x48\x09\x86\xb8\x51\xad\x75\xb4\x92\x7b\xf6\x98\x03\x43\x8e\xa0\x5e\x31\x82\xd9\x86\xdf\x8a\xd7\xce\xe4\x7e\xf9\x79\xc3\xfb\xa9\x2d\x17\x97\xc5\xeb\x27\x28\xbe\xcb\x7a\xb7\x8a\x85\x6d\x3d\xde\xbc\xf0\xf8\xed\xa2\xda\xfb\x74\xed\xb2\x44\xfc\x04\x5d\x14\x09\xe9\x6a\x6b\x8f\xf6\x20\xcb\xcd\x79\x21\xfb\xd9\xd7\xda\x76\xbd\x96\x8d\xbf\x14\xa9\x5f\x94\x97\xdb\x76\x73\x88\xcd\xe1\x78\xb8\x02\x2e\x5f\xf3\x66\xed\xbb\xec\xd7\x2f\xee\xe5\x27\xf6\x6c\x24\x5e\xa1\x1b\xdb\xfa\x72\x73\xbf\x78\x52\x96\xcb\x8b\xeb\xd8\x3f\xbe\xf8\x8e\x67\xf0\xb8\x9a\x96\x87\xb3\x0f\xbd\x84\x17\xba\x9c\x64\xbf\x97\x1f\xaf\xae\x1f\xc2\x76\x48\xf5\x11\x5e\xe1\xd3\xfa\x41\x0e\x0f\xaf\xc0\xef\x07\x59\x1d\x04\xd3\xe1\xc7\xfa\x29\x04\xff\x65\xaf\xbe\x2d\x3d\x36\xf6\xe2\xcf\x96\x7a\x58\xc8\x71\xda\x8e\xe5\xea\x12\xfc\x79\x6a\xe3\x1c\x3a\x2b\xb6\x7f\x6e\x2f\x66\x56\x7c\x78\x8d\xbe\x14\x31\x3c\x21\x4f\xc9\xa5\x73\xe8\xcc\x21\xcf\xcd\x57\xde\xff\x04\x7d\x8b\xcd\x74\x09\x8d\xd5\xf6\xaf\xbd\x4c\x97\xdf\x79\x5e\x32\x3b\xb7\x77\x47\x3d\x1c\xf5\x0d\x68\xf1\xf7\x63\xec\x7f\xbc\x5c\x38\x7f\xce\x14\xaf\x96\xf5\x09\x7a\xfd\x55\x53\x2c\x76\xfb\xed\xf7\x1f\x67\x91\xfd\x6e\xbb\x7d\xc9\xe5\x9c\xad\xfe\x1d\xee\xce\x7e\x2e\x6c\xbb\xd9\x9c\xb7\x77\xf1\xfd\xbc\x79\xdc\x6d\x5e\xba\xef\x97\xdf\xbe\xbd\x90\x98\x8b\x17\xee\x77\xf6\xea\x3b\x4f\xc8\x99\x66\x3d\xb7\x2f\xd7\xf8\x8c\xde\x9f\x1d\x1b\x99\xdb\xab\xa5\xbe\x6a\xbe\xf1\xe8\x19\xbd\xd4\xd3\x67\xf4\xe2\xf9\x87\xe9\x6c\x56\x4e\x8d\xc5\xf4\x7d\xb9\xf1\x17\x29\x2f\xc0\xc5\xe3\xb7\xb7\xf1\xcd\x71\xb5\x7a\xf3\x44\xc8\x4b\x19\xe0\x5b\x67\x44\x3e\x52\x25\x66\x6c\x19\x19\x8d\xa1\x57\x68\x15\xcb\x98\x99\x94\x9a\x33\x85\x85\x78\x40\x57\x7b\xab\x72\x17\xe9\x33\x95\x19\x22\x63\xa4\x32\x24\x81\xb3\x76\x2c\xc3\xa3\x36\xe6\x46\x6c\x46\xdc\x34\x8b\xe5\xda\x40\x59\x4e\x47\xdb\x8a\x61\xd7\x4a\xd4\x3c\x45\xcc\xe1\xc0\x85\xad\x3e\xe5\xef\x4f\x59\xd6\xef\x8b\x9f\xe6\xea\xed\x53\x46\xa7\x8b\x67\xf6\xf3\xaf\xb1\x3c\x33\x0d\x0f\x62\x0f\x82\x69\xb7\x5d\xfd\x80\x9c\xe8\x17\x7e\xdc\x7f\x8b\x0b\xd3\xf6\xf0\xe8\xe3\x2a\x6b\xf9\x7c\xfb\xd5\x85\xc3\x51\xa7\x17\xdb\xb1\x11\x5b\x2d\x74\xfb\xfd\xa2\x7d\x08\xdb\xc7\x74\x86\x6e\x77\xb1\xd9\xdd\xef\x2e\xed\xec\x33\x78\xa9\x76\x3b\x7d\xf4\xf1\xcb\x0e\xbd\xfe\xf8\xfd\x72\x17\x6b\x87\xfa\xeb\xb3\x7e\x1a\xbe\xc5\xe9\xff\x47\x57\x47\x6e\xb5\xcc\x1c\x39\x17\xa1\x82\x92\x25\x46\x2b\x5e\x35\x61\x64\xd2\x68\xa2\x9d\xb3\xea\x29\x40\x3f\x6c\x8f\x7b\x3b\xcd\xcb\xc3\x34\xed\x0e\xff\xb1\x58\xbc\xed\x4b\x9f\x27\xea\x77\x1e\xdd\x73\x1d\x44\xc2\xcc\x54\x92\x23\x6b\x0d\xc9\x86\x21\xc8\xde\xc0\x5a\x55\x70\xee\x94\x87\x9b\xe7\x20\x23\xd3\xd2\x73\x1d\xa8\x02\xd0\x07\xdc\x5c\x25\x9b\xeb\x0a\xbb\x5f\x4e\xf0\xfc\x90\xd9\x2c\xc2\x62\xfe\x73\x7f\x7c\xb1\xb5\x73\x1b\x2f\x1a\x8b\x87\xf9\x49\xbf\x66\xde\x37\x72\xb5\x0a\x4e\x25\xba\xb1\x5f\xbe\xe8\xec\x26\xa6\xf3\x4c\xf7\xb4\x17\xfb\x70\xa4\x8c\x8c\x5a\xb2\xea\x68\xa6\xdd\x33\xb9\x8f\x92\x46\x77\x82\x94\x62\xe4\x9e\x9b\x59\x78\x7e\x23\x98\xb8\xd8\x53\x55\x6f\xa4\x20\x08\xac\x46\x9c\x34\x91\x71\xab\xaa\xa1\xa3\xb4\x51\x82\x39\x67\x28\xa1\x4a\xd6\x4c\x07\xc6\x88\x44\x92\x58\xa0\x17\xa7\xdb\x4a\x78\xf8\x71\x23\xd9\x6f\xbb\xe3\xb3\xbc\xc7\xcd\xf2\xfb\x47\xc5\x2d\x01\x23\x06\xd5\xc4\x04\x14\xd6\x04\x2d\xa2\x29\x79\xe4\xa4\x52\x9d\xb5\xe4\x6e\xfa\x8e\xb8\x82\xa2\x15\x7b\xad\xd6\x88\x0a\xba\x43\x77\xd4\x54\x79\x84\x75\x2a\xd4\x9a\x60\x42\x00\x6f\x96\x42\xaa\x44\x2b\x35\x35\x14\x70\xb3\xb9\xf3\x4d\x71\xdf\x2a\xce\x7d\x5e\x4e\xab\xd5\x99\xe9\xff\xd9\x5c\x9c\x8e\xde\x5d\x5b\x90\xed\x6a\x35\x89\x5e\xe1\xf7\x2f\x75\x89\x2f\xcb\x49\xee\xaf\xb1\xfd\x32\xde\xea\x7b\xb4\x5f\xef\x9a\x3f\xfa\x78\x16\xc3\x1f\xc2\x8e\xfb\x58\xe8\xd2\x97\xfb\xe3\x8b\x91\x9a\xf6\xb2\x39\x8c\xed\xcb\x59\x9a\xe3\x66\x39\x73\xd7\x53\xc7\xd7\x98\xad\x7c\xff\x1a\xdb\xbc\x71\xef\x5e\x36\xf7\xf1\x92\x2c\xf8\x48\x90\x84\x20\xc5\x87\x52\xe4\x3e\xa8\x33\xe5\x04\x6e\x58\x49\xba\xc7\x48\x98\x2b\x99\x65\x4f\xd7\x4e\xe9\xdd\xe3\x24\xa9\x35\x03\x23\x66\x4a\x4d\xb3\xe6\x60\xa0\xea\xd0\x3c\xd7\x80\xa8\xc0\xd8\x23\x58\x29\x0b\x69\xc1\x34\x20\x0d\xec\xa1\x5e\x24\x29\x36\xba\x8c\x15\xb6\xdb\xfb\x55\x7c\x3d\x5b\x12\xf7\xb1\x79\xaa\xee\xbf\xde\x28\x9d\xbb\xca\x6e\x79\x58\xcc\x44\x61\xf6\xee\xc7\xc3\x87\xe2\xa6\x9e\xa3\x2b\x5a\x56\x8e\x5c\xad\xa4\x56\x15\x33\x69\x46\xca\x0d\x19\xa1\x55\x95\xc0\x77\xa4\x46\x17\xcd\x28\x25\x17\x82\xc8\x98\xa2\x70\x4d\x7d\x8c\x42\x05\x87\x0f\xab\x94\x8d\xc9\xcd\x32\x79\x4a\x95\xc2\xe6\x90\x30\xbb\x53\x49\xe1\xad\xbc\x23\xf5\xcc\xa0\x7e\x9f\x22\x50\x59\xc9\xc6\x5e\xd8\xd2\x73\x7b\xa1\x72\x88\x2b\x70\xbf\x3d\x6e\x7c\xbf\xd5\xb3\x08\x67\xeb\x71\x1e\xb0\x6c\xc2\xa6\xe5\xb7\xe5\x74\x16\x0c\x84\xc7\x66\x5a\xca\xcb\xf1\xde\xe7\x08\xe4\x75\x7b\x71\x71\x00\x63\xfe\xf8\x33\xaa\xfc\xac\x3c\x57\xca\xa4\x62\x8f\xdb\xf1\xa6\xfb\xdf\x6c\x62\xf5\x8f\x6b\xed\xdd\xef\x6c\x2f\x9b\x5f\x2a\xf8\x18\xb1\x93\xd5\xf2\x5b\xbc\xb0\xe4\x49\x5c\x26\x79\x61\x04\xeb\xb3\xaf\xdd\xc5\x19\xb7\x8c\xc3\x76\xf5\xed\xba\xbd\xf0\xcd\xe1\x0a\xdb\xc9\xe1\x30\x3d\xec\xb7\xc7\xfb\x87\x73\x32\x79\xc1\x49\x5f\x82\xbf\x49\x76\x17\xca\xbf\xdb\xfe\xcc\xe2\x7e\x24\x6d\x5b\xbb\x54\xe0\xae\xa6\x29\x06\x34\x1a\xcd\xc5\xc2\x92\xf6\xca\x30\x0c\x0a\xb8\xb1\xf6\x37\x76\xe5\xde\xd5\xd3\x5c\x30\xb7\x2e\x56\xdc\x74\xfe\x41\xa5\x74\xf7\x86\x79\xa4\x76\x4a\x67\x00\xe7\x9e\x29\xe3\xa0\x68\x49\x3c\xb0\x40\x46\x2b\xa7\x73\x07\x98\x2e\x73\x5b\xdb\xdd\xe3\xfd\xd7\xe5\x66\xf1\x43\xd6\xab\xaf\xdf\xf0\x73\x19\x0c\x2a\x98\xa4\x6b\x6d\xa5\x78\xd6\x94\x0b\x89\x22\xe7\x51\x87\xa9\x00\x1b\xb7\x3e\x00\xfa\xd5\x91\x59\x7c\x4e\x52\x9f\xe6\xe7\xcb\x3c\xeb\xf3\xdb\x64\x23\xab\x1f\xff\x88\xfd\x97\xe7\xaf\xf4\x38\x55\x56\xff\xc2\x5f\x9e\x01\x7f\xdc\xdd\x2d\x37\xbb\xe3\xf4\x65\xb9\x9e\xe7\xe7\xfc\xb4\xd6\x6f\x4e\xdc\x2f\x5e\x1d\xae\xff\x6d\xdf\xcb\x10\xe7\x03\x5d\x2f\x8e\xc1\x7f\xa4\xff\x59\x98\xf5\x91\xee\x2f\x47\xf7\x7f\xdf\xfb\xad\x33\xfc\xbf\xbf\xe3\xfc\x80\xff\x6f\x7b\x5e\x06\x78\xbf\xed\x7a\x11\x94\xfd\xb6\xe7\xe5\xa1\xfd\x0f\x74\x7d\xe3\x44\xff\x3b\x77\xfd\x3a\xcf\x7f\x77\x73\x83\xf6\xfa\xea\x9b\xd9\xae\xc5\x75\xf6\xe9\xdd\x5b\x5e\x67\x44\x3e\x78\xc3\x45\x2a\xe4\x63\xf7\xbc\xe4\x4a\x3e\xd6\xff\xcc\xcc\xbf\x7b\xc3\xe5\x41\xc5\x27\xd3\xfa\x4b\x55\xef\x77\x87\x2f\xe6\x4f\xe7\x56\x7e\x5e\x3a\xd7\xd6\xff\x1f\x00\x00\xff\xff\x0c\xdc\xd8\x91\xaa\x48\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x7c\xc9\x72\x23\x3d\x92\xe6\xfd\x7f\x0a\xd9\x3f\x87\xbe\x74\x8a\x70\x07\x1c\x4b\x8f\xf5\x5b\xf4\x9c\xca\xda\xca\x7c\x83\xc4\x12\xc9\x60\x05\x83\xb9\xd4\xd3\x8f\x81\xda\x49\x29\xa5\x5f\x75\x49\x11\x1e\x88\xe5\x73\xc0\x77\x47\xfe\x9f\xab\xff\xb9\x5d\x1f\xae\xfa\x7a\xe3\x57\xeb\xc3\x15\x1f\x97\xe9\xc6\x77\x3e\xf3\xe2\xf6\x9f\x57\x36\x5d\xed\xa6\xe5\xca\x6d\xbd\xfc\xdf\x2b\xbd\xe5\xdd\x8d\x1f\xae\xb6\xfc\xeb\x4a\xfc\xea\xb8\xb3\x69\xe7\x57\xf2\xeb\x6a\xb9\xf5\xab\x9d\xff\x5c\xae\xfe\xc3\x7c\x7f\xe5\xbb\xc3\x71\xf6\xff\xb8\xfe\xe3\x8f\x3f\xfe\xf6\xb7\xfd\x3c\xfd\xc3\x75\x39\xfc\xef\xff\xfe\x71\x75\x25\x33\xef\xf4\xf6\xea\xbf\xaf\xfe\xdc\xf2\x61\xf1\xf9\xcf\x3f\xae\xae\x6c\x7d\xe3\x87\x65\xd0\xe0\xbf\x42\xe3\xd2\x4b\x72\xc9\x22\x51\x43\x87\x64\xb5\x61\xce\x10\xb4\x96\x4e\xb9\x29\x69\xef\xb9\x7a\x2b\xd5\x62\xf0\xc6\x91\x52\x60\x97\x8c\xb9\x5b\x0f\xe3\x79\x3b\xde\xfa\x78\xda\xcd\x7a\xb9\x3d\xca\xb5\x4e\xdb\x95\xf0\xbc\xf8\xdd\x6e\x75\x33\x7d\x93\xf5\x3e\xb6\x31\x6d\xcf\x7a\xc7\x03\xcc\x7f\x5f\xfd\xed\xcf\xeb\x3f\xc7\xd7\xed\xe7\xe3\xce\xa7\xfd\x32\x68\x7f\xfe\xbf\xff\x19\xb3\x66\xff\xbe\x3e\xac\xa7\xdd\xa0\x70\xa0\x96\x8b\x73\x68\x64\x15\xb4\x76\x4f\x35\xc6\x52\x72\x29\x49\x3b\xf7\x5e\x9d\x42\xcc\xfa\xe7\x5f\x86\x6d\x99\x3b\xbb\x1b\x50\x40\x66\xac\x5e\x03\x27\xb7\xd0\x2a\x18\x95\xc0\xd6\x40\x90\x4a\x83\x16\x53\x48\x48\x59\x3d\x17\x74\x0b\x9c\x43\xf3\x60\xef\xc1\xf6\x69\xde\x95\xd5\xde\xe7\xbb\xc3\x05\xe4\x7f\x1e\x79\xb7\xac\x37\xfe\x19\xe4\x91\x4b\x01\x6b\x0d\x5b\x89\x1d\x13\x73\x41\xb2\x50\x6a\xae\x92\x72\x31\x30\xeb\xea\xdc\xe5\x12\xf9\x4b\x94\x10\x53\x64\x6c\x39\xa6\x0a\x1c\x03\x29\x27\x0b\x04\x5e\x93\x28\xb2\x18\x64\xc9\x01\xdc\x11\xdd\x22\xa6\x5e\x2d\x07\x32\x07\x36\xc1\x06\xf2\xee\xe2\xde\xf8\x6e\x99\x7f\xad\x0e\x7b\xe7\x3b\xe7\xc3\xaf\xaf\xad\x6e\x62\x16\xc5\x54\x53\x55\xa7\x6e\x11\x1a\xb6\x5e\x0c\x3c\x39\x97\x64\xb1\x84\xa6\x90\xf4\xc4\xeb\xef\x3e\x3f\xde\xf6\x3d\x5c\xc3\x75\xf8\xeb\x4b\xae\xc1\x5c\x7b\x8e\x58\x53\xc4\x90\x0c\xa5\x96\x0a\xe6\x45\x30\x7b\x09\xb1\x52\x4d\x8e\xa6\xaa\x5a\x1c\x7b\x0a\xe0\xb5\xb2\x57\x12\xb0\x8e\x52\xde\x63\xc6\xa2\x87\xe3\x7a\xf1\xf1\xc3\x2e\x18\x21\x8b\xba\x7e\x86\x19\xb9\x38\x95\x68\x08\xc0\xea\xd4\x52\x87\x98\xb3\x24\xf5\x66\xb1\x15\xcc\x9a\x04\x9a\x58\xf8\xfd\x82\xc7\x9a\xcd\x81\xca\x60\x63\x43\x1a\xb2\xdd\xb4\x42\xc7\xac\x1e\x00\x10\xa3\x7a\xe8\xa1\x85\x48\x51\x01\x08\x7b\xb7\x5e\x62\xb0\x62\x10\x58\xf1\x13\x18\x8f\xcb\x7a\x73\x09\xd3\xf5\x36\xe2\x67\x70\x5a\x52\xad\x45\x6a\x0e\x90\xb3\x85\x60\x59\x48\x86\x44\x45\x89\x56\xc0\x72\xa8\xd5\x92\xa5\xdf\xad\xaf\xf9\x77\xdf\x4c\xfb\xf3\x05\x96\x42\x92\x15\x62\x2c\xd1\x19\x7b\x2e\x54\x72\xc1\xaa\x59\x09\x85\x4a\xc2\xd6\xd9\x09\x6a\x0b\xb9\x64\xa1\x66\x66\x14\xd8\x63\x13\x8b\xc2\xf0\x1e\x78\x9d\x0e\xdb\xe9\xf0\xf0\xe7\xdb\xc1\xee\xce\xd1\xff\x71\x75\x75\x75\xf5\xa7\xf0\xc1\x79\xbf\xff\xf3\x3f\xef\x87\xba\x59\xfb\x6e\x79\x3d\x5a\xe9\xb4\x5b\xfc\xe7\x39\xf5\xce\x7f\x1d\xce\x48\xf3\x5e\xcf\x28\xcb\xcf\x33\xc2\x58\x87\xe7\xdb\xb6\xb6\xba\xe1\x35\xaf\xd6\xbb\xf5\xf3\xe3\x27\xf3\xe7\xc7\xcc\xbf\xf6\xcb\xf4\x7a\xf4\xfa\xcd\xcf\xa4\xd5\xad\xbd\x45\xdd\xae\x77\xcb\x9d\xff\x7a\xbc\x74\xf0\xf9\xbb\xcf\xaf\x47\x03\x61\x5f\xdf\x3c\x11\x97\x69\xf6\xc7\xc1\xf2\x6b\xef\x4f\x6f\x7b\x90\xe7\xc7\xe1\xcf\x15\x1f\x97\xdb\xd7\xa3\xd5\x03\x50\xdd\xac\xdf\xbe\xb0\xfc\x94\xe3\x7a\x63\xcf\xdf\xf0\x73\x25\xbc\xbb\x3b\x8d\x3e\xb1\x13\x29\x90\x46\xca\x1d\x83\x05\xab\xae\xe2\x51\x95\x9d\x85\x4b\xcf\xb1\x07\x0e\x50\x95\x63\xfd\xbd\xc4\x79\xe5\x48\x34\x8c\x65\xcd\x11\x72\x2e\xd4\x3b\x25\xb6\xdc\x43\x33\xcc\x64\x80\x4d\x9b\xb5\x9a\x1b\x34\xee\xa5\x53\x02\xee\xc2\x14\xac\x16\xf5\x0f\x36\xdd\xbf\x67\x3e\x09\x81\xaa\xa7\xdc\x8a\xd4\x62\x0e\xd9\x2d\xb6\xe0\x20\xc6\xbd\x42\x74\xaa\x10\x42\xed\xfc\x7b\x84\xbd\x7b\xab\x98\x0c\x5b\x32\x0e\x51\x62\x83\x9e\x92\x03\x7b\xc5\x0a\x58\x41\x12\x77\x1d\x0f\xe5\x46\xb5\x6a\x0b\xad\x94\x4a\x1e\xb9\x43\x18\xda\xef\x6d\x84\xc6\xdf\x5d\x6f\x6e\x87\x87\x70\xd8\xfb\x8f\x0b\x84\x27\xe2\x27\x40\xd6\xd6\x40\x14\x1b\x33\x64\xa5\x54\x75\xac\x46\xe9\xbd\x54\xcc\xc1\xb1\x48\x63\x29\x5a\xe2\xb9\x15\x81\x6b\xb8\x86\xdf\x23\xd7\x92\x53\xd2\x12\x39\x1a\xc6\x92\xa0\x9b\x70\xe5\xd6\x04\x52\x4e\x1e\x10\x18\x31\x49\x71\x0c\x24\x9e\x5a\xc1\x02\x5c\x43\x88\x0c\xc3\xd2\x82\xbc\x83\xdc\xe5\xa8\xb7\x5b\xde\xad\x3a\xaf\x37\xdf\x16\x3f\x2c\x5f\x5b\xde\x46\xbd\x86\x06\xa1\x20\x92\x38\x84\xea\x5d\x3b\x04\x8e\x14\xda\xb0\xa1\x59\xdd\x7b\xd4\xf4\x7b\x90\x2c\x2e\xb1\xb2\x79\xec\x11\xb9\x61\x4b\xd1\x49\x9c\x52\x27\x72\xcb\x96\x3d\x4a\xce\x01\x4b\x06\x2b\x49\x86\xd1\x4e\xda\xcc\x12\x29\x54\x96\xf7\x3c\xa1\x7e\xd8\x4d\xcb\xba\xff\x7a\xfa\xf1\x35\x8c\x8a\x15\x2b\x86\xa8\x56\xc2\x90\x19\x55\xed\x32\xbe\xa5\x57\x81\x5e\x07\xc2\xc6\xd5\xdb\xe5\xea\xa6\xeb\xf2\x7b\xe0\xdd\x3a\x41\x6e\xa1\xc4\x2e\x81\x2d\x9b\x02\x72\x09\x8a\xa9\x41\x22\x8f\xa1\xa7\x40\x35\xa7\x22\xce\x48\x3d\xb0\x58\xaa\x92\xad\x75\xc4\xca\x00\xef\x00\xbf\x99\xbe\xdd\xad\x97\xd5\xdd\xfa\x62\x55\xef\x95\xd4\x66\x7a\x52\x8f\x9b\xe9\x66\xb5\x19\xb6\xec\x25\x61\xf1\x79\xfb\x38\xde\xfa\x32\xaf\xf5\x70\x36\x5c\xd9\xfa\xa0\x3c\xdb\x39\x79\xbd\x5b\x7c\xde\xf1\x66\xb5\xf9\x7e\x7e\x69\x3f\x4f\x5b\x5f\x6e\xfd\x78\xf8\xac\x76\x4c\xa6\x45\x9c\x0c\x0d\xb0\x56\x28\x91\xb0\x46\x51\x96\xf1\x0b\x4a\x75\x68\xa1\x6b\x81\x73\xc6\x87\xeb\x7c\x1d\x7e\xcf\xf8\x08\x0c\xd5\xd8\xb1\xf0\x10\xdb\x50\x12\x01\x79\x8a\x9c\x52\x64\xe9\x86\xc2\xd9\x5c\xb2\xe5\x16\x43\xb3\x6a\x45\xbd\xd4\xa6\x89\x7a\x4c\x99\xda\xfb\x8c\xdf\x4c\x37\x7d\xbb\xac\xee\xff\x7c\x6d\xc3\xc5\x16\x58\x4a\x8b\xe4\x8e\xd5\x35\x0b\xd6\x1c\x73\x12\xe1\x26\xc9\x2c\x27\x08\x2a\xd1\xe8\x12\x77\xfc\x08\x37\xd5\xec\x5c\xb2\x89\x85\x58\x92\xe5\x2e\x39\x35\x6e\x60\x25\x58\x26\x94\xd2\x3d\xa8\xf6\x2e\xb5\x41\xe0\x52\x52\xae\x5e\x4a\x40\x2f\x2d\x40\x8f\xef\x49\xda\x50\xa0\x0b\xeb\xdd\xea\xf4\xef\xd7\x50\x63\x77\xcf\xdc\x81\x5b\x69\xc4\xdc\xc5\x03\x52\xe4\xa0\x5d\xac\xe7\x5c\x1d\xba\x54\x6e\x7c\x8e\x1a\xae\xeb\x87\xab\x4d\x19\xa1\x3b\x8e\xd0\x32\xf4\x40\x1c\x34\x79\xcf\x19\x15\xb3\x06\x96\xe4\x4e\x41\x94\x4b\x65\x8e\xa1\x7b\x2d\x16\x23\x60\x10\xc3\x0a\x99\xfc\x5d\xd4\x37\xd3\x6a\x3f\x4f\xcb\x24\xc7\x7e\x0e\xfa\x7e\xf3\xdf\x4c\x37\xd3\x69\xc6\xa3\x34\xfc\xe3\x30\xed\xf6\xf2\x38\x7a\x75\xe9\x34\xd0\x6f\x37\xbe\xfb\x36\x6e\x5b\x99\x1f\x74\x5e\xef\x97\xe9\xd9\xc1\x99\xe6\xe5\xa5\xbf\xf4\xec\xce\x7c\x82\xbf\x39\x66\xe9\x21\x06\x14\x6d\x44\x85\x2c\xb7\x94\x46\x84\xc9\x98\x43\x04\xca\xbd\x9b\x59\x87\x2f\x18\x29\x28\xdd\x31\x27\xf7\x16\x2a\x77\x2d\x8d\x4a\x4c\x5e\x35\x79\x8e\x26\xc8\xaa\x2c\xdc\xa9\x44\xcc\x26\x1d\xa1\xe4\xc8\xc5\xf2\xf0\xc0\x5b\xc6\xfc\x5e\x8c\x77\x33\x6d\x78\x77\xf3\x01\x87\x5f\xb3\xf0\x95\x83\x77\x3f\x5a\xf1\xee\xd7\x19\xc5\x8e\x33\x2f\x2f\x5c\xbf\x07\xf2\xb2\xde\xfa\x61\xe1\xed\xfe\xb3\x2c\x95\x64\x6e\x1c\x46\x7c\x2c\x49\x4b\x10\x0a\x86\x98\x05\xb8\xa7\xd6\xa3\x31\xb5\x4e\x98\x2f\x04\x15\xbe\x16\x3d\x26\x0e\x9a\x45\x52\x0d\x44\xa1\x72\xc6\x5a\x86\xaf\xc7\x5a\x4b\x16\x47\x56\x80\x8a\x14\x9b\x72\xe5\x88\x29\x57\x1b\xbe\x51\xad\xe8\xcd\x28\x84\xf7\x95\xd6\x89\xcd\x87\x1d\xef\xf7\x5f\x0c\xa3\xd1\x33\xf5\x4a\x48\x64\xa2\x31\x8c\x2c\x45\xc7\x7a\x8a\xab\x86\x47\x90\x0a\xb6\xd2\x3a\x7d\xe0\xe5\x69\x69\x5d\x02\x04\xf1\x58\x99\x9a\x65\x2a\x9a\xaa\x66\x61\xb0\x10\x22\x57\xe6\x4c\xd0\x99\xb2\x50\x69\xd6\xa8\x59\x49\x54\xa2\x14\x1b\x69\x98\xf7\xad\xe1\xbc\xde\x6c\xf8\x29\xf2\xf9\x12\xc2\x50\x85\x7a\xc2\x24\x0d\x0b\xb8\x5b\xcf\xbd\xf5\xa0\x5e\xb3\x4a\x8b\x6d\xb8\xb5\xb1\x70\xc2\x2f\x48\x8f\x97\xd8\x49\x02\x10\x42\xa0\x0e\x55\x14\x22\xf4\x11\x0d\x16\x6b\x2d\xb5\x91\x34\xa9\xb9\xc5\x5e\xb9\x64\xa4\x5a\xb9\x22\xa7\xda\x33\x14\xca\x9d\xfa\x07\xb0\xb7\xc7\x9f\x5f\x5b\x54\x8f\x25\xa0\xb8\x61\xe9\xc1\x62\x2b\xa5\x48\x90\x58\x24\xe7\x24\x19\x6b\xf0\xea\xbd\x76\xe9\x97\x90\xf3\x35\xfe\x1e\x72\x8a\x66\xa1\x32\x84\x4a\x49\x30\x50\xf6\x0c\x64\x20\x60\x88\xac\x2d\x11\x35\xab\x88\x0e\xbd\x4a\xee\xaa\x0d\x15\x18\x02\x15\xaf\x04\xb5\xbe\xaf\x90\xef\x21\xff\x70\x39\x4c\x7a\xe7\x5f\x5c\x6b\xe7\x64\xd0\x73\x05\x61\x11\xf5\x46\x89\xb4\x29\xf5\x68\x04\x2d\xf1\xf0\x03\x6a\xeb\x24\x97\xc0\xf1\x23\x4b\xa4\xc1\xa0\xb1\xe4\x24\x11\xd5\x5b\x77\xd2\x9e\xcc\xd4\x85\x4b\x35\x12\x6d\x35\x94\x1e\x02\x64\x93\x2c\x00\x35\x52\x6b\xc6\xd1\x54\x31\x19\xbc\xa7\x29\x6f\xf9\x70\xbb\xd6\x69\xde\xaf\x6e\xf5\x22\x33\x72\x6f\x6a\xae\x1f\x95\xdd\xad\x6e\x56\x7c\x78\x8a\xd0\xc7\x70\xcf\xf3\xe1\x39\x84\x3d\x51\xe6\x93\x27\xf7\x92\x74\x50\xde\xed\xce\x48\xcb\xac\xd3\xee\xc9\xd3\x1b\xa4\x65\xba\xf3\x27\xbd\x3a\x8c\xdd\xd9\xc3\x4f\xa4\xb3\x47\x9d\x68\xcf\x37\x7e\x62\x85\xaa\x4a\x76\x92\x46\x0d\x23\xa8\x02\x40\xf3\x14\x91\x9a\x26\xce\xa1\xf6\xa6\x04\x8c\xe9\x0d\x5b\x16\x3e\x5a\xa1\x5a\x82\xa5\x34\xbc\x05\x28\x52\x3d\xd7\x66\xa5\xa5\xd6\xbd\x67\x4f\xd1\xbb\x68\xa9\xc5\x29\x74\x0c\x41\x05\xbc\x04\x13\xe5\x86\x21\x31\x58\x96\xf7\xb6\xe6\x7a\xa7\xd3\xee\x70\x3b\xfb\x77\x67\xd9\xf8\x6a\x3b\x1d\x0f\xbe\xcc\xbc\xff\xda\x16\x2d\x39\x63\x66\x6f\xda\x40\x53\x47\x86\xd0\x63\x52\xb6\xaa\x5e\xa3\x73\x42\x6d\x51\xa4\xbc\x61\x79\xbe\x60\x77\x62\x13\x2c\x06\xf1\x94\xc0\x4d\x08\xbd\x55\x88\x2d\x17\xa6\x9a\x73\x17\x8e\x64\x0a\x96\xac\xa7\xc8\xd9\xbd\x7b\x94\xc2\x94\x52\x52\x09\x05\x21\xbd\xc3\x92\x7f\x6c\x6f\x27\xbb\xf1\xc3\x88\x3f\xd6\x37\xd3\xd7\x18\xa1\x09\xad\x79\x50\x0e\x18\x1d\xa1\x55\xc0\x00\x2d\xf7\x9a\xb0\x04\x10\x49\x4a\x64\x45\xda\x5f\x47\xcd\x39\x79\xc4\x68\x1a\xb2\x94\x58\x1b\x3a\x89\x90\x85\x14\xd4\x2d\x15\xd2\x94\x13\x59\xa2\x1c\x62\x8d\x31\xd6\x1a\x3b\xd5\x16\x13\x8b\xf4\xdc\xcb\x7b\x59\x95\xbb\xf9\xdf\x8a\x0d\xa4\x26\x8f\x81\xd5\x08\x88\xd9\x34\x49\xa9\x91\x2d\xf5\x5e\x23\xf7\x1e\xb1\x35\xb1\xee\x1f\x6c\x72\xa5\x5c\xad\x14\x2c\xcc\x98\x51\x23\x8a\xf5\xca\xb1\x17\x93\x1a\x33\xa4\x5e\xb8\x07\xb7\x9c\x21\x95\x24\x98\xa8\x56\xf3\x1c\x49\x31\x04\x4c\xfa\x5e\x1e\x7a\xcb\x37\x6b\x9d\x76\xbc\x9e\x57\xfb\x79\xda\xfb\xbc\xac\xfd\xf0\x35\x9c\x8a\x91\x62\xcc\x78\xaa\x84\x14\xe9\x8c\x58\x21\x35\xcd\x58\xd3\x58\x61\x0c\xa0\x5d\x0a\x7c\x21\x1a\x18\x05\x16\x0a\x38\x76\x71\x06\x88\xda\xb4\x6a\x62\x8d\x01\xa8\xc6\x9a\xa0\x12\x69\x1d\x6a\x99\xab\x16\xec\xb9\x65\x89\x2e\xc3\xe4\x5b\xf0\xe4\xf4\x2e\xf8\x65\x39\x15\x9b\xd6\x07\x5e\x96\x2f\x3a\x52\x79\x94\x47\xa4\x53\xb2\x58\xdd\x19\xb8\x35\x94\xa8\x05\x91\x4b\x66\x32\xd0\xa4\x82\xa4\xe7\xb0\xc3\x75\xb8\xfe\x20\xc9\xd2\x3b\xb9\xb0\xc7\xa4\x5d\x7a\x48\xc5\x28\x90\x3b\x50\x30\xc7\xe2\x39\x50\x09\x5e\x35\xb6\x24\x51\x6a\x37\x91\x82\xa1\xf7\xcc\x5a\xa0\x55\xea\xef\xe5\xd0\x06\xec\x65\x3f\x4f\x47\x7b\x70\x24\xff\xfe\xe8\xaf\xff\xdd\x7f\x2e\xbe\x1b\x9f\x78\xb9\x07\xf6\x32\x72\xc7\x9f\xda\x08\x80\x71\x54\x67\xb0\x9a\xa7\xe0\x6e\x01\x62\x66\x46\xc9\x29\x59\x70\x0f\x99\x82\x53\xbe\xe0\x08\x5c\x87\x8f\x1c\xaf\x52\x45\x04\x84\x49\x8a\xc6\x3e\x2a\x6b\xce\xe0\x2c\x54\xc4\x2c\x56\x6a\xec\x5a\x1c\x4a\xa9\x00\x94\xa3\x9b\x26\x80\x36\xbc\xb0\x20\x21\xf3\x7b\x8e\xd7\x76\xbd\xe8\xad\x6f\x36\xa7\xbc\xe2\xed\xb4\x75\x5b\xcf\x5f\xdb\x0d\xec\x30\x9c\xe8\x2a\x11\x03\x09\x65\xd0\xd2\xbc\x3a\xf5\xdc\xa4\xf7\x11\x77\x64\xa8\xd4\xd3\x5b\xd8\x3f\x10\x02\x8a\xa2\x49\x6d\xe4\x99\xd4\x8a\x1a\x21\xc4\xd6\x5a\x30\x82\x91\x0a\x30\x6d\xa3\x44\xc1\x1e\x41\x29\x53\x0c\x19\x41\xa0\x4a\xa4\xae\x31\x44\xa8\x1f\x62\xdf\xf2\xfe\xb0\xcc\x47\x5d\x8e\xb3\x7f\x0d\x7d\xa4\x98\x47\x32\xd0\xcd\x4c\x1a\x93\xc4\x24\x96\x6b\x06\xd3\xc4\x2d\xa7\xa2\x92\xa8\x75\xbf\x44\x0f\x1f\xf9\x9f\x8d\x4a\x02\x1b\xe9\x61\xcc\x81\x1b\xaa\x92\x96\x1e\x7b\x0c\x19\xbc\x12\x0e\x8f\x9c\x2b\x08\x99\x60\xb0\xd3\x6b\x8d\x4b\x92\xc1\xa6\x5c\xde\x4d\x08\xec\x7d\xb3\xf1\x65\xed\xf3\x58\xf9\x65\xda\x6e\xbe\x06\x5c\x03\x18\x60\x09\xbd\x47\x4f\x09\xb9\x32\x15\x35\x53\xd0\x86\xa6\x00\x63\x6b\x56\x68\xe9\x0b\xfe\x67\x0a\x0e\x8d\x1a\x14\x6e\x04\x5c\xa5\xd7\x5c\xd4\x46\xc1\x15\x39\x65\xe6\x0e\x35\x64\xa5\xa0\xbd\xa1\xbb\xf4\xa4\x90\x7b\xa9\x6a\xc3\xa6\x96\xf4\x9e\xe2\xdf\xdf\xdd\xac\x7c\x9e\xa7\xf9\x8b\xda\x3e\x27\xf2\x1e\x42\xa2\xe6\x56\x13\x03\x34\x68\x45\xba\x54\xab\x18\x28\x24\xd4\x6c\x3d\xc7\x37\xca\xb0\x1f\x6b\xfb\x80\x55\x47\xdd\x12\x3d\x69\x04\x40\x44\x52\xab\x05\x6a\x09\x1d\xad\x69\x4f\x4d\x9a\x24\x2c\x99\x22\xf4\x10\x53\xac\x5c\xab\xb7\x24\x5e\x73\x7f\x77\xa9\xb7\xfe\x2f\x9e\x87\xce\xfb\x66\xeb\xde\x37\x6b\xb9\x40\xfe\x48\xff\x04\xfe\xd2\xb0\xd4\xac\x25\x85\xc0\x10\x33\x56\x54\xc8\x39\xe5\x4c\xec\x81\xab\x49\x43\xd0\xac\xf8\x05\x41\x57\xe0\x30\x1e\xd4\x5b\x86\xe8\xa1\xa2\x03\x86\xa6\x1d\x6b\x95\x9e\x53\x4f\x21\x57\x53\xcb\x5a\x0b\xe7\x24\x7d\xf8\x93\x3d\x84\xcc\x96\x10\x85\xdf\x8b\x38\x9e\xb3\xb9\x0f\xa5\xb0\xbf\xdf\x6b\xff\x73\x2e\x3c\x24\x58\x5e\xe7\x7e\x5f\x51\x86\xab\xb0\xbd\x5d\x96\x4f\x67\x5d\x46\xd6\x16\x5a\x55\xb3\x16\xa4\x03\xaa\x41\x4c\x6c\xa5\xd9\xa8\x57\x73\x1e\x2b\x3a\x6a\xec\x7f\xdd\xe5\x43\x23\xb5\x0c\xc6\x4c\x94\x09\x6a\x71\xb0\x96\x85\x3d\x27\x13\xd1\x1c\x6a\x30\xd6\x5e\x12\xa4\x54\xbd\x65\x6c\x9a\x53\xb7\x16\x31\x44\x09\x96\x3e\xcd\xab\xed\x64\x7e\xa9\x1c\x6e\xa6\xcf\xec\x15\xd2\x58\x0b\xd8\xa8\x39\x05\xe9\x12\xb1\x53\x57\x16\x64\x4e\xd2\x5c\x73\xf5\x4c\xdc\x5a\xff\xeb\xf0\x75\x84\xf9\x1c\x94\x0d\x5a\x64\x4d\x4d\xb2\x63\x22\xc8\x1e\x7a\x6b\xc3\x0f\x04\x2b\x8a\x05\x12\x3a\x31\x55\xaf\x62\x10\xcd\xbb\xa0\x35\xd6\xfa\x09\xf8\xd3\x76\x3b\xed\xde\xde\x23\xfe\x73\x3f\xbc\xe2\x87\xfd\xf1\x54\x48\x90\xf5\x22\xc7\x11\xcb\x5f\x4f\xf3\xcd\xea\xc7\x8f\xd5\xcd\x34\x9a\x95\x76\xfe\x54\xc2\xb8\x67\xe6\x27\x37\x8f\xa8\x94\x64\x1e\xaa\x8d\x74\x0d\x14\x95\x5c\x6a\x73\x57\x18\x22\x00\x21\xa4\x30\x9c\xa3\xf0\xd7\xb9\xe7\xbd\x0c\x6b\xda\x00\x7b\x6b\x5a\x5a\x8a\xc9\x9a\x86\x96\xa9\x62\x49\xb9\x54\xd1\x4a\xa1\x06\x17\x8f\x2c\x11\xc5\x87\xb3\x15\x5c\xd4\xc9\xa1\xeb\xc7\xdc\xdb\xcf\x93\xf6\xc3\x07\xe1\xfd\x13\xe3\x4e\x0e\xd6\x03\x71\xd7\x9f\x04\xef\x67\x3f\x7c\x96\x57\x50\x49\x12\xd6\x9a\x20\x1a\x72\x18\x29\x9b\x70\xb2\x06\x2d\x98\xf9\x70\x0b\xb1\x39\xb1\x5f\xf2\xea\xd5\xae\x4a\x44\x99\x53\xf2\x48\x23\x41\xca\xa9\x05\x4a\xc9\x9a\x84\xec\xad\x0b\x37\xc5\x9a\x15\x07\xff\x83\xa7\x51\x9c\xee\x91\x1b\x42\xa2\x56\xb0\xd5\xf7\xf8\x32\xeb\x3c\xfd\xd8\xf8\xaf\xa1\x81\x1f\x4a\x4c\x5f\xb3\x3d\x8e\x25\x24\x87\x4c\x23\xd3\x3f\xa2\xc5\x90\xb1\x53\x83\x26\x49\xb0\xa5\x96\xbc\x75\x2e\xed\x03\x35\x9b\x79\x98\x2d\xe1\x92\x13\x53\x36\x2c\x54\x6b\x1b\xe2\xd8\x63\x4d\xd5\x30\xe5\x56\x2d\xd5\x94\x70\x2c\x78\x25\xf7\xc2\x71\x68\xa9\x9a\x08\xb3\xbe\x57\x58\x39\xec\x3b\xc4\x15\x77\x9f\xa7\x0f\xd6\x7d\xeb\xdb\xcf\xae\xab\xa5\x50\x09\x94\x39\x58\x49\x25\xb6\x68\x0c\xbd\x0b\xd6\x5e\x7a\xb3\x2a\xc9\xbd\x77\x17\xfb\x82\x63\x35\x94\x45\x01\x71\x2b\x94\x30\xd7\x16\x4b\xa7\x62\xc9\x87\x05\x0e\x1e\xa0\xd1\xc0\x1b\x63\xee\x5c\xa2\x09\x6b\xad\xa1\xb6\x04\x39\x79\x03\x85\xf7\x5c\xea\x7b\x36\x28\x7f\xb5\x4c\x5d\x5b\xa6\x18\x49\xaa\x16\x08\x25\x22\x20\x56\x8f\xa7\x04\xa7\xb2\xb4\x1a\xb1\x10\x08\xeb\x17\x3c\xaa\xd2\xbb\x86\x56\x63\x88\x24\xa3\x69\xab\x15\xe3\x98\x6b\xb5\xd6\x1d\x9a\xe5\xc0\x09\x83\x37\x8d\x55\xbc\x63\xec\x35\x91\x52\x2c\xb5\xba\x65\x0e\xe4\xbf\x47\x3c\xc9\xcc\x5f\x83\x5c\x04\x95\x58\x5b\xd7\x90\xba\x92\x77\xee\x92\x43\x09\xa1\x40\xb4\xd4\x47\x0f\xa1\x8c\x94\xee\x39\xe4\xf0\x71\xdc\x94\xab\x73\x72\x8c\x05\x62\xab\xcd\x14\x83\x80\x98\x1b\x59\x33\x46\xa5\xde\xc4\x21\xf5\xde\x6a\x25\x71\xef\xa9\x42\x4d\xd5\xcd\xa0\x8e\x82\xdb\x7b\x3d\x09\xf7\x8b\xfc\x8f\x1f\xbc\x59\x7c\xfe\xe1\xbc\xdc\xfa\xbc\xe5\xdd\xd7\xe0\x27\x4e\x29\x64\x4f\xa5\x2a\x67\x6c\xe1\x54\xc7\xcc\xb5\x6b\x8c\x3d\xf6\x90\x12\x94\xa8\x81\xf3\x97\x3c\x2a\x01\x08\x88\x09\xbc\xf4\x9c\x48\xb4\x7a\x50\x44\xf6\x48\xe8\xb5\x07\xd3\x9c\x6a\x92\xac\x92\x0c\x22\x76\x6e\x1d\x13\x94\x34\xda\xdf\xda\xbb\xed\x36\xf7\xf0\xf7\x7d\xc3\x37\x5f\x83\x8c\xad\x42\xc5\x9e\xab\xe6\xac\x81\x10\x9b\x4b\x88\xac\x50\x80\xc5\xb3\xc7\xd0\xdc\xcb\x5b\x05\xd4\x70\x1d\x7f\x0f\x79\x54\x57\x38\x97\xca\x48\x05\x1c\x33\xf5\x24\xbd\x01\x47\x27\x8f\xc8\x9c\x65\x24\x8a\x98\x48\x25\x38\xd7\xd4\x0a\x05\x55\x28\xa7\x62\x81\xb5\xfc\x5b\xc8\xdf\xd7\x7b\xff\x62\x80\x8c\x24\x31\x30\x87\x1c\xbb\x42\xf5\x54\x73\x46\xa9\xb9\xb5\x8c\x84\xce\x62\xda\xb1\x07\x2d\x6f\x41\xfe\x48\xae\xbd\x1a\xe6\xd2\xc2\xe8\xe4\xe1\xd2\x23\x01\x36\x46\x8e\x85\x5a\xf6\x58\xdd\x42\x07\x50\x2e\x29\x5b\xb6\x26\x5c\xb0\xb4\x1a\xdc\x21\xd6\xde\xfa\xbb\x90\x97\xd9\x17\xbd\x9d\x57\x8b\x1f\xde\x6a\x49\xb9\x57\xe6\x7c\x38\xf8\xfc\xe4\x0b\xcd\xfe\xcf\xe3\xfa\xa1\x89\xed\x13\x4c\x01\x94\xdc\x4b\xf4\x1c\x6a\x32\xb6\x50\xb9\x68\x76\x2a\x84\x35\x09\x94\xe2\xca\x23\x8b\xfd\x46\xea\x0c\x3f\x92\x7c\x89\x7a\x1f\x94\x09\xe4\x00\x5c\x53\x4e\x30\x72\x27\x11\x34\x44\x2e\x2d\x43\xcd\xa8\x82\xda\xaa\x69\x0f\x6d\x24\xe4\x4b\xac\x29\x7a\xb6\xc6\xf8\xae\xe4\xff\xda\xd9\x32\x82\xe6\x53\x27\x8a\x5d\x84\x52\x0f\x9d\x29\x0f\x17\x1f\xb8\xf2\x30\x77\xa5\xac\xb7\x7e\x41\x9c\xb6\x7b\x9e\x7d\x3e\xa7\x3f\x04\xa9\x67\xd4\xbe\xde\x2c\x97\x73\xd7\xcb\x68\x87\x9f\x2e\xe8\xff\x98\x8e\xc3\xc1\x3a\x27\x6f\x7d\x7b\xf9\x71\xd3\x7e\x39\x27\x1d\x96\x69\xe6\x9b\x8b\x2f\x5e\x46\x59\xe0\x9c\xf8\xe4\xc3\x7d\x62\xd9\x35\x69\x1e\x5e\x8c\x37\x8f\xa5\x33\x40\xe9\x14\x95\xb8\x85\x6c\x51\x72\x0c\x2d\x58\x4d\x89\x7e\xbf\xc2\x39\x90\x64\x4a\xb9\xc7\x9e\x62\x49\x94\x1b\x61\xab\xae\x68\x31\xa1\x45\x6f\x84\xa3\xff\x08\xd4\x7a\xeb\x31\x35\x71\x6e\x11\xa8\xe7\x52\xac\x50\x7b\xcf\x80\x2f\xbe\x33\x9f\x47\xf7\xe6\xbf\xd9\xa3\xec\x54\x53\x18\xf5\x98\x9e\x7a\xef\x41\x29\xf7\x26\x99\x29\x81\x23\x4a\x8e\x54\xc1\xb9\xd9\x17\xa2\x9f\x50\x0b\x33\x97\x86\xc1\xc9\x82\xf4\xd2\x68\xc4\xff\x42\x65\xf4\x2d\x6b\x24\x1d\x46\xbe\x65\x96\x14\xdb\x90\x75\x00\xf4\xca\x3c\xfa\x1c\x4a\x41\xfc\x18\xb9\x1b\x12\x41\xfb\xc0\x91\x73\xfb\xc1\xb3\x1d\xee\xa7\x3e\xd2\x7e\x2e\x33\x3f\x53\x3e\xc1\x27\xab\xb1\x96\x80\x64\x28\xcd\x80\x46\x3e\xdb\xbb\x84\xe2\xc5\xa5\x57\x48\xa2\x3a\x7a\x06\x3f\xe8\x4f\x43\x6d\x05\x38\x91\xd6\xa6\x8c\xa3\x2b\x3d\x12\x77\x0a\x0d\x9a\x9a\x7b\xa0\x2e\xa6\x94\x2c\x49\xa7\x90\x91\x42\x89\xb9\xc5\x18\x82\x47\x70\xb6\xfa\x31\x4f\x6e\xa6\x6f\xbc\x5d\xef\xbe\x58\xfd\xe9\xcc\xd9\x4b\x84\x96\x92\xa3\x14\xc9\x23\x89\x65\x18\xb0\x05\xac\xe3\x90\xc1\x70\xb5\xc5\x2f\x52\x84\xe1\x1a\x3e\x74\xec\x28\xc6\x56\x42\xab\x96\x59\xa5\x64\x88\x91\x6a\xae\x51\xb5\x26\x76\x6a\x58\x81\x73\xd0\x1c\x62\xec\x41\x7a\xcf\xd8\xb9\x5a\xec\x58\x9a\x66\x4d\x31\x7c\x8c\x7d\xcd\xdf\x37\x5f\xc3\x1d\x59\xb5\x8d\x7e\xa8\x0a\x60\xc3\xd1\x4b\xa1\x71\x25\xe2\x51\x31\xf7\x53\xf9\xb2\x55\xc7\x37\xf2\x65\x00\x1f\xe1\xe6\xdc\xdc\xc5\x80\x64\x64\xde\xa8\x77\x13\x08\xdc\x6a\xf2\x10\xa0\xb3\x29\xe5\x3c\x72\x81\xb1\x46\xf3\x11\x18\x36\x77\xaa\x19\xdd\x5b\xd3\x6e\xfa\x31\xee\xe7\x9f\xef\x58\x3f\xd1\xf5\x43\xa6\xe4\x51\x00\x4e\x24\xff\xc9\xdb\xfd\xc6\x57\x3a\x99\xbf\x79\xe1\xee\xfb\xab\x6e\xef\xd3\x4d\xaf\x5b\xc4\x4f\xa4\x57\x4d\x42\xb2\x99\xf4\x4e\x6f\x79\xfd\x54\xb5\xd6\xad\xbd\xfc\x5a\x9d\xb6\x5b\xde\xd9\xd3\x0d\xaf\xdb\xcb\x47\x55\x77\x9c\x7c\x7a\x79\xf9\x9e\xf0\xfa\x35\x6f\xf6\xbe\xf3\xbc\x9d\xe6\x33\xda\xa3\x92\x38\xa3\xee\x74\xb2\xf5\xee\x66\x75\x2f\x2c\xaf\x2f\x6e\x7d\xbe\xdb\xf8\x39\xf1\xb8\x59\xd6\x87\xf5\xcd\x3b\xe4\x95\xac\x17\x9e\x67\xfe\x75\x76\xfd\xe0\xba\x47\xca\x77\x70\x46\x5f\xb6\xa3\xc7\xe0\x8c\xf8\xf3\xc0\x9b\x03\x63\x38\xfc\xda\xde\x87\xe0\x8f\xd7\xfd\xfb\xda\x7c\xa7\x4f\xdf\xb4\x59\xcb\x61\x35\xd2\x36\xe3\xbc\xd9\x2b\xe2\xc3\xa9\x8d\x97\xa4\x17\xcd\xf6\x8f\xe3\xd5\xf0\x8a\x0f\xe7\xd4\xe7\x26\x86\xfb\x79\xf7\xc9\xa5\x97\xa4\x17\x06\x79\x0c\xcf\xac\xff\x89\xf4\xdd\x77\xcb\x6b\x52\xdf\x4c\x3f\x66\x5e\x5e\x7f\xe7\xcb\x96\xd9\x31\xde\x1f\xe5\x70\x7c\xfd\xf4\x7b\xd2\xea\x9f\x47\x9f\x9f\xb8\xba\x59\xbf\x7c\xce\xe2\x67\xdb\xfa\x44\x3a\xff\xaa\xc5\x57\xfb\x79\xfa\xf9\xf4\x8c\xad\x6f\xf7\xd3\xf4\x9c\xcb\x79\xb1\xfb\xf7\xf8\x74\xd8\x63\x8f\xfb\xd1\xe1\xf4\x84\x7f\x8c\xf7\xfe\x74\x62\x63\x0c\x8f\xfb\xdd\xf3\xf4\x79\xfd\xfd\xfb\xb3\x13\xf3\xea\x85\xf3\x5e\xcf\xbe\xf3\x44\x79\x21\x59\x8f\xe3\xd7\x7b\x7c\x50\x6f\x5e\x1c\x1b\x19\xe3\x91\x00\x7f\x3d\x7c\xe3\xd1\x9b\xb5\x9c\xc9\xe9\x23\xf5\xd5\xf3\x0f\xcb\x8b\x55\x39\x0d\x56\xcb\xcf\xf5\xce\xfc\xe7\x9b\xc4\xd5\xdd\xf7\xb7\xe9\xbb\xe3\xe6\x09\xf8\xab\x17\x3c\xb7\x01\x3e\x52\x1e\x94\xe7\x67\xad\x6e\xd0\xa6\x11\x1b\x6a\x83\x9a\xa1\x64\x4c\x7d\x78\x52\xa2\xd6\xc8\xd5\xd9\x7c\xb4\x77\xbd\xd5\xb9\x8b\x74\x1d\xfe\xba\xcf\xc2\xdc\x7b\x48\x9d\x03\x58\x93\x8a\xa9\x9b\xe7\xd2\x5a\xa1\xa6\x4a\xad\x48\x64\x8d\xb9\x80\x34\x3e\x1d\x6d\x4b\x8a\x55\x32\x51\x19\x85\xd3\x11\x0e\xbc\xd2\xd5\xa7\xfc\xfd\x29\xcb\xfa\x73\xf5\xa0\xae\xde\x54\xcf\x72\xba\xf8\xc8\x21\xd9\x4c\x3f\xfa\xfa\x85\x6a\xb8\x65\xbd\x65\x0c\xfb\x69\xf3\x0b\x62\xa0\x27\xfa\x71\xfe\xee\xaf\x54\xdb\xed\x9d\xf5\x8b\xac\xe5\xe3\xed\x17\x17\x0e\x47\x59\x9e\x75\xc7\x8e\x75\xb3\x92\xe9\x69\xe1\x4f\xe3\x83\xeb\xec\xcb\x0b\xea\xb4\xf7\xdd\xfe\x66\xff\x5a\xcf\x3e\x12\x5f\x8b\xdd\x5e\xee\xac\x3f\xe9\xa1\xf3\x8f\x9f\xd7\x7b\xdf\x1a\xe4\xf0\x48\x78\x50\x7c\xab\xd3\xdf\xcf\xee\x8e\x58\x72\x1a\x3e\x72\x4c\x4c\x09\x39\xf2\x48\x19\x5b\x96\x80\x1e\x49\xbc\xb0\xd4\xd1\xb5\x73\x0a\xd0\x0f\xd3\x71\xd6\x93\x0d\x1d\xa5\x91\xc3\x7f\xad\x56\x6f\xdb\xd2\xc7\x85\xfa\x9d\x45\xb7\x98\x3b\x8d\x9a\x40\xa3\x14\x0c\x47\x5a\x9f\xa3\xa2\x33\x36\x2b\xa0\x25\x0b\x58\xab\x14\xbb\xa9\x45\x27\x25\x95\x54\x63\xee\x28\x0c\x50\x3b\xbc\xbb\x4b\x76\x97\x1d\x76\x0f\x8b\xfd\xfa\x90\xd9\x80\xb0\x1a\xff\xdc\x1c\x9f\x75\xed\x18\x3f\x31\xfc\x34\x58\xdd\x8e\x27\x3d\x92\xd6\xb6\xe3\x8b\x5d\x30\x64\xf3\xe0\xf3\xfa\x59\x66\x77\xbe\x3c\x45\x49\x43\x84\x67\x56\xff\xec\x82\x60\x43\x49\x51\xa4\x17\x95\x6a\x91\xcc\x7a\x0a\xbd\x1a\x41\x08\xde\x63\x8d\x45\xd5\x2d\xf6\xdf\xb3\x37\x89\x15\x12\x60\x84\x26\x4a\x2d\x48\x20\x6d\x25\x8b\x8c\xfa\x69\xe9\xc9\x5b\x8b\x11\x92\x8b\x90\x16\x95\x8e\xde\x3d\x10\x87\xc6\x50\x93\xd1\xfb\x42\x78\xf8\xf5\x4e\xb2\x5f\xf7\xc7\x47\xbc\xc7\xdd\xfa\xe7\x67\xe1\x26\x87\xee\x9d\x72\x68\xe3\xb0\x83\x16\x46\x75\x2f\x42\xe6\x31\x8c\xf3\x0e\x4d\x52\xac\x2a\x1f\xc0\x65\xe4\xd1\xfe\x99\xb3\x16\xa2\x84\x23\xab\x67\x28\x21\xb7\xee\x5a\x29\x51\x29\x8c\x01\x61\x94\x8a\x82\x73\x66\x2f\x29\x87\x82\x0c\xa6\x3a\x26\xbf\x0b\xf7\xad\xe6\xdc\xc7\xed\xb4\xd9\xbc\x50\xfd\x0f\xc3\xd5\xe9\xe8\xdd\xc5\x16\x19\x57\x17\x96\x0b\xfa\x8d\xef\x2e\x68\x0b\xdf\x5c\xd2\xe6\xb5\xbf\x35\xf7\xa8\x4f\xa7\x5d\xc6\x47\x1f\x5f\xc4\xf0\x07\xd7\xe3\xec\x2b\x59\xdb\x7a\x3e\x3e\x2b\xa9\x65\xe6\xdd\xa1\x4f\xcf\x67\x69\x8e\xbb\xf5\xf0\x5d\x4f\x13\xcf\x69\xba\xb1\xf9\x9c\xb6\x7b\xe3\xde\x79\x1c\xc6\x7f\x4e\x16\x7c\x62\xd9\x3b\x02\x27\xeb\x42\x1e\xeb\x68\x51\xa3\x18\xc0\x14\x33\x71\x35\xef\x01\x63\x26\xd5\x68\xe1\xd2\x28\x7d\x78\x9c\x24\x94\xa2\xa0\xd4\x1a\x85\x22\x51\xa2\x37\xa0\x6c\x50\x2c\x66\x07\xcf\xd0\xb0\xba\x37\xa1\xc8\xa3\x92\x14\x3a\x84\x8e\xd5\xc5\x12\x07\xc1\x42\xaf\x63\x85\x69\xba\xd9\xf8\xf5\x8b\x2d\x71\xe3\xbb\x53\x2f\xd1\xf9\xa6\x78\x98\xca\xfb\xf5\x61\x35\x1c\x85\x61\xf5\x8f\x87\x4f\xc5\x4d\x35\x7a\x15\xd4\x28\xcd\x63\xd6\x14\x4a\x16\x8c\x24\x11\x29\x16\x6c\xe3\x64\x82\xb0\xe3\x07\xa8\xd1\x46\xfd\x8e\x53\x4c\x04\x1e\x31\x78\x6a\xa3\xe9\xb4\x27\x4a\xd8\xad\x6b\xa6\xa8\x8d\x4c\x35\x92\x85\x30\xaa\x4a\x23\x24\x8c\x66\x94\x82\x5b\x49\x1f\xa0\x1e\x1e\xd4\xef\x53\x04\xc2\x1b\xde\xa9\xcf\xe7\xe3\xd5\x38\x00\x7c\x41\x9c\xa7\xe3\xce\xe6\x49\x5e\x44\x38\x93\x3d\x2b\xd0\xe1\x34\xba\x2e\xeb\xef\xeb\xe5\xc9\x03\xd4\xd9\xcd\x77\xcb\x9a\x9f\x8f\xf7\x3e\x46\x20\xe7\xe3\xd5\xab\x03\x18\xc3\xfd\xdb\x4c\x17\x42\x75\x3e\x5e\x09\xeb\xdd\xd4\xdf\x34\xff\xbb\x9d\x6f\xfe\x75\x71\x61\x3c\x78\xe6\xdd\x93\x08\xde\xb9\xef\x79\xb3\xfe\xfe\x04\x77\xeb\x0b\x1b\x2f\x4f\x26\x63\x37\x22\xa5\xa7\x0f\xd9\xfb\x0b\xdf\xd2\x0f\xd3\xe6\xfb\xe5\x78\x65\xbb\xc3\x05\x6d\xcf\x87\xc3\x72\x3b\x4f\xc7\x9b\x27\x0f\x67\x6c\xb7\xa7\x89\x0f\x7b\xef\x61\xb4\xf0\x93\x83\x7d\x12\xfe\xfd\x34\x2f\x9f\x15\x53\xc8\x95\x33\xb4\x2a\x23\x87\xde\xa1\x50\x2f\xc6\xea\x1a\x46\x42\x1b\xba\x42\x02\xd3\x26\xf5\x5c\x4c\xe1\x1a\x3e\x94\xd3\x98\x30\x96\xca\x9a\x4c\x65\xfc\xa0\x94\xaa\x59\xc1\xd8\x43\x39\xa5\x33\xa0\xc5\x1a\x69\xf4\x12\xf8\xf8\x9f\x27\x1c\x13\x44\xd4\x74\x3a\x77\x80\xe1\x75\x6e\x6b\xda\xdf\xdd\x5c\xaf\x77\xab\x5f\xbc\xdd\x5c\x7f\xc7\xaf\x65\x30\x28\x61\xe0\x2a\xb9\xa4\x64\x51\x42\x4c\xc4\x82\x2d\xf6\xdc\x55\x18\x9a\xb6\x52\xfb\x68\x7f\x3f\x47\x8b\x8f\x49\xea\xd3\x1a\x7e\x1b\xab\x3e\xde\xc6\x3b\xde\xfc\xfa\x97\xcf\xdf\x1e\xbf\xd2\x7c\xff\xe7\x4b\xfa\xf3\x33\xe0\x8f\xab\xab\xf5\x6e\x7f\x5c\xbe\xad\xb7\x63\x7d\x5e\x08\xd8\xe5\xe1\xe7\xe7\x13\xf7\xab\xb3\xc3\xf5\xbf\x9d\xfb\x3a\xc4\xf9\xc4\xd4\x57\xc7\xe0\x3f\x33\xff\x45\x98\xf5\x99\xe9\xcb\xcf\xcf\xcd\x7e\xeb\x0c\xff\xef\xef\x78\x79\xc0\xff\xb7\x33\x5f\x07\x78\xbf\x9d\xfa\x2a\x28\xfb\xed\xcc\xd7\x87\xf6\x3f\x31\xf5\x91\x21\x2f\x92\x0c\x1f\xdc\xf5\x74\x9e\xff\xea\xdd\x02\xed\xe5\xd5\x17\x1e\xfa\x8b\x9f\x97\xd9\xa7\x0f\x6f\x79\xcc\x75\xfc\xc5\x1b\x5e\xa5\x42\x3e\x77\x8f\xc9\x5f\x9b\xff\x42\xcd\x7f\x78\xc3\x33\xe6\x21\xac\x27\xd1\x7d\x16\xd5\x9b\xfd\xe1\x9b\xda\xfd\xb9\x95\x87\x4b\x2f\xa5\xf5\xff\x0f\x00\x0c\xdc\xd8\x91\xaa\x48\x00\x00"),
 		},
 		"/Gopkg.toml": &vfsgen۰CompressedFileInfo{
 			name:             "Gopkg.toml",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 314750649, time.UTC),
+			modTime:          time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 			uncompressedSize: 1359,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x93\x4f\x6f\xab\x38\x10\xc0\xef\x7c\x0a\x0b\xae\x8d\x8d\x71\x20\xa1\x52\x0e\x7b\xda\xfb\x9e\x76\x55\xf5\xe0\x3f\x83\xe3\x0d\x78\x78\xb6\xe1\xb5\x97\xf7\xd9\x9f\xa0\x79\x6d\x94\x36\x55\x7b\x01\xcb\x0c\xbf\xdf\x8c\x66\xa6\x20\x7f\xe3\x78\xb2\x34\xe1\xd0\x13\x78\x92\xc3\xd8\x43\x56\x64\x05\xf9\x07\x3a\x08\x24\x21\x39\xa6\x34\xc6\x7b\xc6\xac\x4b\xc7\x49\x51\x8d\x03\xb3\xd8\x4b\x6f\x99\x81\x91\xa9\x1e\x15\x1b\x64\x4c\x10\x98\x41\x1d\xd9\x1b\x8e\x0e\x26\x2b\x48\x87\x81\x18\x48\xd2\xf5\x60\x2e\x5d\x06\xf5\x34\x80\x4f\x32\x39\xf4\x74\x55\x06\xf8\x31\xb9\x00\x86\x1c\xc8\x43\x7e\xa1\x9b\x22\x04\x96\x8e\xce\x5b\xa6\x07\xf3\x72\xca\x1f\xb3\x82\x38\xeb\xf1\x56\xfc\x18\xf0\x7f\xd0\x89\x8d\x27\xfb\x6f\x7e\x47\x72\xe5\x92\x9a\xf4\x09\x12\xc5\x60\xdf\x85\xfc\xb5\x3c\xfe\x5b\xa0\x59\x41\x1e\x1e\x34\xfa\x98\x82\x74\x3e\x3d\x2e\x1e\x42\xbc\x1c\x80\x1c\xc8\x2d\x4b\xbe\x06\xcd\x10\xa2\x43\xbf\xc4\x71\x5a\xd2\x32\x3f\xd3\x70\x86\x10\x9c\x81\xdb\xac\x27\xf6\xfc\x0e\x51\xd1\xed\x2b\x62\x0c\x93\x87\xf3\xdf\xe8\x37\x16\xc9\x81\x74\xb2\x8f\xb0\x5e\x59\xdc\x24\x88\x29\x92\x03\x49\x61\x7a\xb9\x9b\xfc\x14\xc1\x6c\x46\xa9\x4f\xd2\xc2\xeb\xa7\xec\xaa\xb6\x0f\xb3\xd1\x18\x07\x8c\xe7\xd7\x26\x9a\x53\x9e\x11\xa2\x82\xf4\xfa\xb8\x44\x1a\x98\xa1\xc7\x31\x5f\x60\x17\xa5\x7d\x88\x3a\x4f\xca\x18\x30\xa1\x9a\xba\x05\x74\x51\xe2\x81\x53\xbe\xd4\xf8\xa5\xac\xe2\xd8\x71\xc1\x34\xaa\x20\xaf\x30\xbf\x4a\x5a\x52\xfe\xa5\x7c\x12\x78\x03\x61\x70\x3e\x31\x8b\x1b\x39\x38\x8f\xd7\x39\xcd\x25\xe5\xd5\x39\xab\x6f\xe0\x9c\x9c\xfb\x8f\x50\xfc\xfb\xa8\xb7\xe3\x35\xb0\xa4\x55\xbd\xf2\xb2\xa2\x20\x29\x48\x1f\x5d\x72\x33\x10\x03\x63\xbc\x23\x3f\x5d\x3a\xe2\x94\x48\x80\x1e\x64\x84\x78\x9f\x15\x5f\x31\xc7\x67\x6f\x52\x58\x5a\xb5\x34\xd6\xa8\x45\x1a\x60\x76\x7f\xac\x7a\xab\x1b\xde\xd4\x1c\x5a\x10\xbb\x4e\x72\xbe\xeb\x6a\xa1\x6b\xd9\x96\x8d\x11\xaa\x11\x65\x5b\x9a\xfd\x76\x5b\x7f\x52\xe6\x3a\x05\xeb\xe2\x3d\xb1\xf8\x1c\xaf\x0d\x5b\xe0\x1d\x74\x75\x53\xb6\x35\xaf\x41\xef\x64\xa5\x01\x76\xaa\x36\x20\x4a\x25\x1b\xd3\xaa\xad\xd8\x6b\xd5\x7d\x66\x40\xdb\x03\xbd\x10\x59\xf0\xeb\xd4\x5d\xbb\xc4\x5e\xc0\x5e\x55\x5a\xa8\x16\x44\xa3\xb7\xe5\xae\x51\x95\xa8\x95\xa8\x6a\xb1\xab\xda\x8a\xef\x1a\x25\xa1\x5a\x9b\x76\x5e\xbb\xf7\x1b\x76\x73\xbf\x7e\x07\x00\x00\xff\xff\x7b\xc9\x7c\x53\x4f\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x93\x4d\x73\x9b\x30\x10\x86\xef\xfc\x0a\x0d\x5c\x63\x09\x21\x83\xed\xcc\xf8\xd0\x53\xef\x3d\xb5\x93\xc9\x41\x1f\x8b\xac\x1a\xb4\x54\x12\x34\xb9\xf4\xb7\x77\x20\xce\x97\x13\x67\x92\x93\x35\xf2\xcb\xf3\xec\xc2\x6e\x41\xbe\xe3\x70\xb4\x34\x61\xdf\x11\xb8\x93\xfd\xd0\x41\x56\x64\x05\xf9\x01\x2d\x04\x92\x90\x1c\x52\x1a\xe2\x35\x63\xd6\xa5\xc3\xa8\xa8\xc6\x9e\x59\xec\xa4\xb7\xcc\xc0\xc0\x54\x87\x8a\xf5\x32\x26\x08\xcc\xa0\x8e\xec\x19\x47\x7b\x93\x15\xa4\xc5\x40\x0c\x24\xe9\x3a\x30\x2f\x5d\x06\xf5\xd8\x83\x4f\x32\x39\xf4\x74\x51\x06\xf8\x33\xba\x00\x86\xec\xc9\x4d\xfe\x42\x37\x46\x08\x2c\x1d\x9c\xb7\x4c\xf7\xe6\xe1\x94\xdf\x66\x05\x71\xd6\xe3\xa5\xfc\x10\xf0\x37\xe8\xc4\x86\xa3\xfd\x99\x5f\x91\x5c\xb9\xa4\x46\x7d\x84\x44\x31\xd8\x37\x91\x6f\x73\xee\xd7\x0c\xcd\x0a\x72\x73\xa3\xd1\xc7\x14\xa4\xf3\xe9\x76\xf6\x10\xe2\x65\x0f\x64\x4f\x2e\x59\xf2\x25\x34\x41\x88\x0e\xfd\x9c\xe3\xb4\xa4\x65\x7e\xa2\xe1\x04\x21\x38\x03\x97\x59\x77\xec\xfe\x0d\xa2\xa2\xeb\x27\xc4\x10\x46\x0f\xa7\xa7\xd1\xaf\x2c\x92\x3d\x69\x65\x17\x61\xb9\xb2\xb8\x4a\x10\x53\x24\x7b\x92\xc2\xf8\x70\x37\xfa\x31\x82\x59\x0d\x52\x1f\xa5\x85\xa7\xbf\xb2\xb3\xde\xde\xad\x46\x63\xec\x31\x9e\x7e\x56\xd1\x1c\xf3\x8c\x10\x15\xa4\xd7\x87\x39\x69\x60\x82\x0e\x87\x3c\xcb\x5e\xb5\xf6\x2e\xea\x34\x29\x43\xc0\x84\x6a\x6c\xf3\xec\x55\x8b\x7b\x4e\xf9\xdc\xe3\xa7\xaa\x8a\x43\xcb\x05\xd3\xa8\x82\x3c\xc3\xfc\x2b\x69\x49\xf9\xa7\xea\x49\xe0\x0d\x84\xde\xf9\xc4\x2c\xae\x64\xef\x3c\x9e\xd7\x34\x95\x94\x57\xb4\xfc\x2a\xce\xc9\xa9\x7b\x0f\xc5\xbf\x8e\x7a\x3e\x9e\x03\x4b\x5a\xd5\x0b\x2f\x2b\x0a\x92\x82\xf4\xd1\x25\x37\x01\x31\x30\xc4\x2b\xf2\xd7\xa5\x03\x8e\x89\x04\xe8\x40\x46\x88\xd7\x59\xf1\x19\x73\xbc\xf7\x26\x85\x79\xa9\xe7\x0f\x6b\xd4\x2c\x0d\x30\xb9\x47\xab\x5e\xeb\x86\x37\x35\x87\x1d\x88\x4d\x2b\x39\xdf\xb4\xb5\xd0\xb5\xdc\x95\x8d\x11\xaa\x11\xe5\xae\x34\xdb\xf5\xba\xfe\xa0\xcd\x65\x0a\x96\xc5\xbb\x63\xf1\x3e\x9e\x1b\xd6\xc0\x5b\x68\xeb\xa6\xdc\xd5\xbc\x06\xbd\x91\x95\x06\xd8\xa8\xda\x80\x28\x95\x6c\xcc\x4e\xad\xc5\x56\xab\xf6\x23\x03\xda\x0e\xe8\x0b\x91\x05\xbf\x4c\xdd\xb9\x4b\x6c\x05\x6c\x55\xa5\x85\xda\x81\x68\xf4\xba\xdc\x34\xaa\x12\xb5\x12\x55\x2d\x36\xd5\xae\xe2\x9b\x46\x49\xa8\xe6\x97\xfc\xb8\x76\x6f\x37\xec\xe2\x7e\xfd\x1f\x00\x7b\xc9\x7c\x53\x4f\x05\x00\x00"),
 		},
 		"/app.go.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "app.go.tmpl",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 314904737, time.UTC),
+			modTime:          time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 			uncompressedSize: 2048,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x51\x6f\xe3\x36\x0c\x7e\x96\x7e\x05\xe7\x87\x83\x5d\x78\xce\x7b\x80\x3c\xa4\xed\xe1\x70\x6b\x93\x0d\x97\xad\x2f\xc3\xb0\x93\x65\xc6\xd5\x12\x4b\xaa\xa4\x2c\x09\x82\xfc\xf7\x03\x65\x27\xb1\xdb\xe0\xda\x97\x44\x26\x3f\x92\x1f\x3f\x51\xb4\x42\xae\x44\x8d\x20\xac\xe5\x5c\x35\xd6\xb8\x00\x29\x67\xa5\x68\x20\xa9\x55\x78\xde\x94\x85\x34\xcd\x48\x1a\xdf\x18\xdf\xfd\xfd\xea\xab\xd5\xa8\x14\x1e\x85\xb5\x09\x67\x3f\xc7\x49\x53\xa1\x4c\x38\xf3\xd5\xea\x9d\x8c\x61\x6f\xd1\xbf\x9b\x6f\x37\x12\x9b\xf0\xfc\x01\x58\x29\xf4\x2a\xe1\x4c\x94\x52\x0d\x0a\x07\xd4\x15\xba\x46\xe9\xd0\x3f\x12\xec\xcc\x40\x36\xfa\x03\x21\x6b\x55\x52\xc9\xa6\x31\x3a\xe1\xac\x2a\x9b\x8f\xc6\x54\xe5\x2b\xfa\x3f\xc1\xae\x4d\x9d\xf0\x8c\x73\x69\xb4\x8f\x37\x23\xac\x9d\x8b\x06\x61\x02\xc9\xe1\x00\x45\x3c\x1f\x8f\x11\x33\x1a\xc1\x6c\x3f\xb5\x16\x96\x6a\xd7\x20\xa7\x6e\x3a\x83\x0f\x6e\x23\x03\x1c\x38\xbb\x29\x45\x53\xdc\x0a\x8f\x53\x6b\x39\x93\x95\x84\x9b\x78\x43\xc5\x1d\xfd\x72\xce\x56\xb8\x9f\x09\xa5\x01\x00\x6e\x7c\xb5\x2a\x1e\x9e\x16\xc1\x38\x7c\xc0\x7d\xf4\x4d\xa5\x34\x1b\x1d\xde\xf8\x38\x13\xad\x67\x26\xac\x45\x07\x74\x49\xc5\xb4\x6f\xa2\xa1\xd2\xab\x07\x44\x72\x03\x00\x7d\x15\xed\x27\x3f\x46\xf2\x73\xdc\xf6\xf9\x2f\x37\x5a\x9e\x6d\xe9\xda\xd4\x35\x3a\x58\x9b\xba\x78\x8c\xc7\x1c\xaa\x12\xaa\xb2\x29\xee\x6f\x33\xb8\x69\x03\x0f\x6d\x4b\xe3\x09\xcc\xc4\x0a\x63\x4b\x69\xc6\x59\x49\xbe\xf1\x04\xa8\xf7\x39\x6e\xbb\xf6\xd3\x4e\xca\x1c\xd6\xe7\x84\x79\x4b\xfc\x1e\x97\x62\xb3\x0e\x7f\xee\xee\x91\xd4\x71\xa9\xac\x64\x96\x71\xce\xfe\x17\x8e\x9e\x0a\x4c\xe0\x53\xac\x78\xe0\x8c\x75\xe9\xc6\x40\x65\x72\xce\x88\xc2\x98\x3a\x04\x59\xc9\x9c\x73\x76\xd2\x34\x1a\x49\xb7\x39\x6e\x2f\xd2\xa5\x49\x23\x94\x4e\xb2\xbc\x05\x76\x9a\x8d\xaf\x01\x85\x94\x11\x77\xe4\x71\x0e\x8a\xa1\xe4\x93\x96\xfb\x1c\xb7\x03\xdd\x53\xce\x22\x38\x92\x69\x8f\x97\x32\xd1\x42\x51\x7f\x38\x13\x4c\xec\xe4\xec\xc8\xba\x2a\xbd\x6b\x9b\xb4\xb7\xd6\x69\xd8\x1a\xd3\x37\x4c\x4e\x81\x0b\x0c\x5f\xb5\x0a\x77\xcf\x42\xe9\x0e\xa7\x2e\xdf\x27\xd4\x8c\x02\x63\x8f\xfe\xeb\xf4\xe9\x31\xbd\x70\x24\xcd\xae\x52\xa6\x50\x74\x8e\xae\x94\x7c\x8f\x46\x54\x8f\x22\xa0\x0f\x4f\xe8\xbc\x32\x3a\xed\x25\xc8\x38\x53\x4b\x20\xf4\x2f\x13\xd0\x6a\x4d\x33\x42\x0f\xbc\xf8\xbc\x53\x21\x45\xe7\x8a\xcf\xce\x19\x97\x66\x59\xab\xab\xc3\xb0\x71\x3a\xee\xc3\x76\x2a\xbf\xa0\x46\xaf\xfc\x22\x88\x80\xfd\x97\x35\xb0\x5f\x1e\x58\xc7\xd2\xc3\xdf\xff\x44\x61\x7b\x9a\xc2\xf7\xff\xbc\xd1\xe3\xa4\x13\xcb\x27\xdf\xa9\x44\x1c\x73\x62\xdc\x0d\x71\x06\x3d\x91\x52\x19\x76\x71\x12\xee\x8c\x0e\xb8\x0b\x39\x38\x7c\x01\x5a\x56\xc5\x37\x7c\xd9\xa0\xbf\x28\x9c\x9d\xcc\xde\x1a\xed\xf1\x6c\x27\x52\x9e\x48\xfe\xb6\xf8\x7d\x4e\x92\x39\x7c\x29\xa6\xd6\x46\xe2\xb7\xfb\x80\x9e\x73\x56\xf7\x9b\x19\x4f\x40\xe3\x36\xed\x37\x98\x0d\x04\x97\x95\x2c\xfe\xd2\x8d\x70\xfe\x59\xac\x29\x6d\x7a\x2e\x90\x43\x3d\x0c\x7b\x2b\xbe\x15\x5a\x49\x52\xbe\x53\x7c\x69\x1c\xfc\x9b\x83\x90\xf1\xe1\x3a\xa1\x6b\x1c\x24\x29\xce\x92\x52\xb4\x90\xf2\x64\x98\x6f\x9a\xb2\x9d\xfb\xd7\x13\x58\x7c\xc1\x30\xc7\x5d\x18\x00\x49\xcb\xac\x1b\xa7\x21\x7a\x81\x27\x24\x61\x72\xf8\x24\xa4\x7c\x35\x0e\x57\xa5\x3d\x1c\xbb\x19\x39\x6f\x9b\xfe\xea\xea\xad\xa0\xc1\x92\xa5\x36\x68\x8f\xd0\xa6\x9a\x40\xeb\x98\xe3\x96\x16\x55\x9c\x98\x6f\x58\x2b\x1f\xd0\xb5\xb1\xb4\x79\xda\xd5\x79\xd5\x41\xb3\x71\xcd\xde\xa6\x3d\x7b\xdc\xde\x06\xd3\xb9\xba\x96\x64\x25\xf9\x91\xff\x08\x00\x00\xff\xff\x28\x35\x8e\xdd\x00\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x8f\xe3\x36\x0c\x3d\x4b\xbf\x82\xf5\x61\x61\x0f\x52\xe7\x1e\x20\x87\xf9\x58\x2c\xb6\x33\x49\x8b\x4d\x3b\x97\xa2\xe8\xca\x32\x93\x51\x13\x4b\x1a\x49\x69\x12\x04\xf9\xef\x0b\xca\x72\x62\xef\x04\xbb\x73\x49\x64\xf2\x91\x7c\x7c\xa2\x68\x85\x5c\x8b\x15\x82\xb0\x96\x73\xd5\x58\xe3\x02\xe4\x9c\x55\xa2\x81\x6c\xa5\xc2\xcb\xb6\x2a\xa5\x69\xc6\xd2\xf8\xc6\xf8\xf4\xf7\xab\xaf\xd7\xe3\x4a\x78\x14\xd6\x66\x9c\xfd\x18\x27\x4d\x8d\x32\xe3\xcc\xd7\xeb\x9f\x64\x0c\x07\x8b\xfe\xa7\xf9\xf6\x63\xb1\x0d\x2f\xef\x80\x55\x42\xaf\x33\xce\x44\x25\xd5\xa0\x70\x40\x5d\xa3\x6b\x94\x0e\xfd\x23\xc1\xce\x0c\x64\xa3\xdf\x11\xb2\x51\x15\x95\x6c\x1a\xa3\x33\xce\xea\xaa\x79\x6f\x4c\x5d\x7d\x47\xff\x07\xd8\x8d\x59\x65\xbc\xe0\x5c\x1a\xed\xe3\xcd\x08\x6b\xe7\xa2\x41\x98\x42\x76\x3c\x42\x19\xcf\xa7\x53\xc4\x8c\xc7\x30\x3b\xdc\x5a\x0b\x4b\xb5\x6f\x90\x53\x37\xc9\xe0\x83\xdb\xca\x00\x47\xce\x6e\x2a\xd1\x94\x77\xc2\xe3\xad\xb5\x9c\xc9\x5a\xc2\x4d\xbc\xa1\xf2\x9e\x7e\x39\x67\x6b\x3c\xcc\x84\xd2\x00\x00\x37\xbe\x5e\x97\x8f\xcf\x8b\x60\x1c\x3e\xe2\x21\xfa\x6e\xa5\x34\x5b\x1d\xde\xf8\x38\x13\xad\x67\x26\xac\x45\x07\x74\x49\x65\x02\xb7\x26\x1a\x2a\xbd\x7e\x44\x24\x37\x00\xd0\x57\xd9\x7e\xf2\x13\xe7\xe3\x31\xcc\x71\xd7\xe7\xbf\xdc\x6a\x79\xb6\xe5\x1b\xb3\x5a\xa1\x83\x8d\x59\x95\x4f\xf1\x38\x82\xba\x82\xba\x6a\xca\x87\xbb\x02\x6e\x22\x88\x1a\xa4\x96\x26\x53\x98\x89\x35\xc6\x96\xf2\x82\xb3\x8a\x7c\x93\x29\x50\xef\x73\xdc\xa5\xf6\xf3\x24\xe5\x08\x36\xe7\x84\xa3\x96\xf8\x03\x2e\xc5\x76\x13\xfe\xdc\x3f\x20\xa9\xe3\x72\x59\xcb\xa2\xe0\x9c\xfd\x2f\x1c\x3d\x15\x98\xc2\x87\x58\xf1\xc8\x19\x4b\xe9\x26\x40\x65\x46\x9c\x91\xaa\x13\xd2\x0f\x64\x2d\x47\x9c\xb3\x4e\xd3\x68\x24\xdd\xe6\xb8\xbb\x48\x97\x67\x8d\x50\x3a\x2b\x28\xf2\x22\xf0\xe4\x1a\x50\x48\x19\x71\x27\xce\x99\xb0\xb6\x1c\x4a\x3e\x6d\xb9\xcf\x71\x37\xd0\x3d\xe7\x2c\x82\x23\x99\xf6\x78\x29\x43\x45\x63\xd4\x1f\xce\x04\x13\x3b\x39\x3b\x8a\x54\xa5\x77\x6d\x24\xa1\x5e\x77\x1a\xb6\xc6\xfc\x0d\x93\x2e\x70\x81\xe1\xb3\x56\xe1\xfe\x45\x28\x9d\x70\xea\xf2\xdd\xa1\x66\x14\x18\xc5\xf0\x9f\x6f\x9f\x9f\x3a\xba\x49\xb3\xab\x94\x29\x14\x9d\x83\xc9\x94\x2e\xa3\x7c\x32\xa2\x7e\x12\x01\x7d\x78\x46\xe7\x95\xd1\x79\x2f\x41\xc1\x99\x5a\x02\xa1\x7f\x99\x82\x56\x1b\x9a\x11\x26\x1b\x5d\x7e\xdc\xab\x90\xa3\x73\xe5\x47\xe7\x8c\xcb\x8b\xa2\xd5\xd5\x61\xd8\x3a\x4d\x79\xd3\x54\x7e\x42\x8d\x5e\xf9\x45\x10\x01\xfb\x2f\x6b\x60\xbf\x3c\xb0\xc4\xd2\xc3\xdf\xff\x44\x61\x7b\x9a\xc2\xd7\xff\xbc\xd1\x93\x2c\x89\xe5\xb3\xaf\x54\x22\x8e\x39\x31\x4e\x43\x5c\x40\x4f\xa4\x5c\x86\x7d\x9c\x84\x7b\xa3\x03\xee\xc3\x08\x1c\xbe\x02\x2d\xab\xf2\x0b\xbe\x6e\xd1\x5f\x14\x2e\x3a\xb3\xb7\x46\x7b\x3c\xdb\x89\x94\x27\x92\xbf\x2d\x7e\x9f\x93\x64\x0e\x5f\xcb\x5b\x6b\x63\x43\x77\x87\x80\x9e\x73\xb6\xea\x37\x33\x99\x82\xc6\x5d\xde\x6f\xb0\x18\x08\x2e\x6b\x59\xfe\xa5\x1b\xe1\xfc\x8b\xd8\x50\xda\xfc\x5c\x60\x04\xfd\x54\x57\xc5\xb7\x42\x2b\x49\xca\x27\xc5\x97\xc6\xc1\xbf\x23\x10\x32\x3e\x5c\x27\xf4\x0a\x07\x49\xba\x35\xe2\x63\xb4\x90\xb2\x33\xcc\xb7\x4d\x15\x87\xf2\xcd\x04\x96\x9f\x30\xcc\x71\x1f\x06\x40\xd2\xb2\x48\xe3\x34\x44\x2f\xb0\x43\x12\x66\x04\x1f\x84\x94\xdf\x8d\xc3\x55\x69\x8f\xa7\x34\x23\xe7\x6d\xd3\x5f\x5d\xbd\x15\x34\x58\xb2\xd4\x06\xed\x11\xda\x54\x53\x68\x1d\x73\xdc\xd1\xa2\x8a\x13\xf3\x05\x57\xca\x07\x74\x11\x1c\x37\x4f\xbb\x3a\xaf\x3a\x7c\x7d\xdd\xde\xa6\x3d\x7b\xdc\xc1\x06\x93\x5c\xa9\x25\x59\x4b\x7e\xe2\xdf\x06\x00\x28\x35\x8e\xdd\x00\x08\x00\x00"),
 		},
 		"/cmd": &vfsgen۰DirInfo{
 			name:    "cmd",
-			modTime: time.Date(2018, 11, 4, 21, 45, 11, 315378193, time.UTC),
+			modTime: time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 		},
 		"/cmd/{{ .Name }}cli": &vfsgen۰DirInfo{
 			name:    "{{ .Name }}cli",
-			modTime: time.Date(2018, 11, 4, 21, 45, 11, 315127308, time.UTC),
+			modTime: time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 		},
 		"/cmd/{{ .Name }}cli/main.go.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "main.go.tmpl",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 315269655, time.UTC),
+			modTime:          time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 			uncompressedSize: 1273,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x51\x6b\xdb\x30\x10\x7e\x96\x7e\xc5\x4d\x8c\x61\x83\xa7\x30\xf6\x16\xc8\x43\xea\x86\x76\xd0\x76\x1d\x65\x7b\x19\x7b\x50\x4e\x97\x54\xd8\x96\x5c\x49\x2e\x29\xc1\xff\x7d\xc8\x71\xd2\x34\x5b\x4b\x9f\x2c\x74\xdf\x7d\xdf\x77\x9f\xce\xad\xc2\x4a\xad\x09\x1a\x65\x2c\xe7\xa6\x69\x9d\x8f\x90\x71\x26\x5c\x10\x9c\x33\xd5\xb6\x20\xb6\x5b\x90\x17\xee\xb6\x5a\x43\xdf\x0b\xce\xc4\xda\xc4\xfb\x6e\x29\xd1\x35\x13\x74\xa1\x71\x61\xfc\x7c\x0e\xba\x9a\x60\x6d\xc8\xc6\x77\xc2\x26\x15\x3d\x85\xf7\x62\x7d\x8b\xef\x85\xc6\x8d\xe0\x4c\x75\xf1\x1e\x1b\x0d\x6f\x77\x6c\x26\x09\xb7\x6f\xc4\xda\x9c\x68\x84\x76\xf5\xe5\xeb\x04\xdd\xd2\xab\x93\x4a\x24\xab\xc9\x37\x26\xe9\x3d\x1f\x6b\xb3\x0c\x3b\x9a\x9c\x73\x74\x36\x44\x08\xd1\x79\x9a\x23\xc2\x0c\x84\x42\x14\x9c\x3f\x2a\x9f\x42\xf6\xce\xc5\xb2\xd1\x30\x83\x4f\x83\x80\x2c\x5d\xd3\x28\xab\xb7\x9c\xb1\x9f\x81\xa6\x00\xbb\xf0\x6f\x54\x43\xd0\xf7\x89\xb5\xe0\x8c\xdd\xdd\x3b\x1f\xa7\x2f\x4a\x50\xee\x62\x2f\x38\xeb\x39\x3b\xa7\x95\xea\xea\x58\x5e\x7d\xbb\x74\x0d\xc1\x0c\x5c\x90\x8b\x4d\xab\xac\x5e\xd8\xc7\x4c\x7c\xbc\xfc\x7e\xbd\x98\xc8\x13\xe6\x3c\x19\x5e\x75\x16\x87\x5d\xc8\x72\xd8\x72\xb6\x73\xb5\xb0\x6a\x59\xd3\xe8\xed\xce\xf9\x68\xec\x1a\x66\xb0\x52\x75\x20\xce\x50\x23\x4c\x67\xa0\xda\x56\x5e\xab\x8a\x4a\xa7\x09\xb3\x9c\x1f\xa6\x93\x73\xad\xc7\xde\x6c\x97\xb2\x2c\x9d\x5d\x99\x75\xd9\xe8\x2c\xcf\x39\xf3\x2d\x1e\x61\x42\x36\xf6\x25\x8a\x87\x8e\xfc\x53\x4a\x68\xfa\x46\x44\x00\x62\xc0\x0d\xd9\xcc\x6b\xa3\x02\x85\x29\xfc\xfe\x13\xa2\x37\x76\xbd\x15\x0f\xa2\x3f\x4a\x0d\x40\xfc\x48\xe8\x34\x43\xe8\x96\x38\xaa\xee\x92\x7b\x56\x3c\x76\xcd\xd9\xe0\xf1\xac\x76\x58\xed\xef\xf2\x62\xbc\xfd\xa5\x6a\xa3\x55\x74\xfe\xb8\x92\x73\x16\x37\x2f\x86\xda\xf3\x16\x80\x1a\xf3\xff\xeb\x8c\xe9\x5c\x19\x4b\x67\x9e\x54\xf5\x36\xec\x82\xe2\x81\x9d\xb3\xfd\xb6\xa7\xeb\x39\xa2\xeb\x6c\xca\x30\xdb\xaf\xde\x20\x5b\xc0\xbf\xa0\x73\x42\xa7\xc9\x67\xc9\xd5\xe0\x5c\x4a\xf9\xca\xe3\x71\x76\xb0\x93\x86\x3f\x75\x3b\x8c\xfd\x5a\x63\xfa\xcf\xe5\xc1\x6e\x3e\x62\x69\x43\xd8\x45\xe7\xd3\xf3\x62\x6d\xe4\xad\xa7\x56\x79\xba\x56\xc6\x26\xf3\x23\x55\x01\xe2\xe6\x4e\x14\xf0\x72\xab\x73\xce\xc8\x0f\x9d\x7b\x16\xb9\x18\x0e\x94\xe5\x9c\x99\x15\xa4\xea\x87\x19\x58\x53\xa7\x4d\x66\xad\xb2\x06\x33\xf2\x3e\x4f\x0f\xdd\xf3\xbf\x01\x00\x00\xff\xff\x5a\xd2\x40\xd4\xf9\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\xc1\x6e\xdb\x3a\x10\x3c\x73\xbf\x62\x1f\xf1\xf0\x20\x01\x7e\x34\x8a\xde\x0c\xf8\xe0\x38\x46\x52\x20\x49\x53\x04\xed\xa5\xe8\x81\x5e\xae\x1d\x42\x12\xa9\x90\x54\xe0\xc0\xd0\xbf\x17\x54\xe4\xc0\x71\x9b\x20\x27\x09\xe4\xcc\xec\xec\x70\x5a\x4d\x95\xde\x32\x36\xda\x3a\x00\xdb\xb4\x3e\x24\x2c\x40\x48\x1f\x25\x80\xd0\x6d\x8b\x72\xbf\x47\x75\xe1\x6f\xab\x2d\xf6\xbd\x04\x21\xb7\x36\xdd\x77\x6b\x45\xbe\x99\x92\x8f\x8d\x8f\xe3\xe7\xff\x68\xaa\x29\xd5\x96\x5d\xfa\x20\x6c\x5a\xf1\x53\xfc\x28\x36\xb4\xf4\x51\x68\xda\x49\x10\xba\x4b\xf7\xd4\x18\x7c\x9f\xb1\x9b\x66\xdc\x81\x48\xb5\x3d\x99\x11\xdb\xcd\xa7\xcf\x53\xf2\xeb\xa0\x4f\x6e\x12\x3b\xc3\xa1\xb1\x2e\x1d\xff\xd6\x76\x1d\xb3\x9a\x84\x12\x80\xbc\x8b\x09\x63\xf2\x81\x17\x44\x38\x47\xa9\x89\x24\xc0\xa3\x0e\x39\xe4\xe0\x7d\x5a\x36\x06\xe7\xf8\xdf\x30\x40\x2d\x7d\xd3\x68\x67\xf6\x20\xc4\xf7\xc8\x33\xc4\xe7\xf0\x6f\x74\xc3\xd8\xf7\x59\x75\x02\x42\xdc\xdd\xfb\x90\x66\xaf\xae\x70\x39\xf8\x97\x13\x10\x3d\x88\x73\xde\xe8\xae\x4e\xcb\xab\x2f\x97\xbe\x61\x9c\xa3\x8f\x6a\xb5\x6b\xb5\x33\x2b\xf7\x58\xc8\x7f\x2f\xbf\x5e\xaf\xa6\xea\x44\xb9\xcc\x86\x37\x9d\xa3\xa1\x0b\x45\x89\x7b\x10\xcf\xae\x56\x4e\xaf\x6b\x1e\xbd\xdd\xf9\x90\xac\xdb\xe2\x1c\x37\xba\x8e\x0c\x82\x0c\xe1\x6c\x8e\xba\x6d\xd5\xb5\xae\x78\xe9\x0d\x53\x51\xc2\xcb\x76\x6a\x61\xcc\xc8\x2d\x9e\x53\x56\x4b\xef\x36\x76\xbb\x6c\x4c\x51\x96\x20\x42\x4b\x47\x98\x58\x8c\xbc\x2c\xf1\xd0\x71\x78\xca\x09\xcd\xde\x89\x08\x51\x0e\xb8\xbc\xbc\x58\xd4\x56\x47\x8e\x33\xfc\xf9\x2b\xa6\x60\xdd\x76\x2f\x1f\x64\x7f\x94\x1a\xa2\xfc\x96\xd1\x79\x87\xd8\xad\x69\x9c\x9a\xc9\xfd\xd1\xc4\x63\xd7\x20\x06\x8f\x67\xb5\xa7\xea\x70\x56\x4e\xc6\xd3\x1f\xba\xb6\x46\x27\x1f\x8e\x6f\x4a\x10\x69\xf7\x6a\xa9\x83\xee\x04\xc9\x50\xf9\xf7\x39\x63\x3a\x57\xd6\xf1\x59\x60\x5d\xbd\x0f\xbb\xe0\x34\x9e\xc4\x02\xc4\xa1\xed\xea\x82\xd3\x82\xc8\x77\x2e\x37\xab\x38\x54\x6f\x18\x3b\xc1\x3f\x41\xe7\x4c\xde\x70\x28\xb2\xab\xc1\xb9\x52\xea\x8d\xc7\x03\xf1\x62\x27\x2f\x7f\xea\x36\x93\xdf\x24\x56\xfc\x14\x0f\x4f\x17\xc7\x88\x40\xf0\x8e\xa9\x4b\x3e\xe4\xfe\x50\x6d\xd5\x6d\xe0\x56\x07\xbe\xd6\xd6\x65\xf3\xa3\xd4\x04\xe5\xcd\x9d\x9c\xe0\xeb\x56\x97\x20\x38\x0c\xcc\x83\x8a\x5a\x0d\x3f\x5c\x94\x20\xec\x06\xf3\xed\x3f\x73\x74\xb6\xce\x4d\x16\xad\x76\x96\x0a\x0e\xa1\x04\xd1\x43\x0f\xbf\x07\x00\x5a\xd2\x40\xd4\xf9\x04\x00\x00"),
 		},
 		"/cmd/{{ .Name }}d": &vfsgen۰DirInfo{
 			name:    "{{ .Name }}d",
-			modTime: time.Date(2018, 11, 4, 21, 45, 11, 315437217, time.UTC),
+			modTime: time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 		},
 		"/cmd/{{ .Name }}d/main.go.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "main.go.tmpl",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 315560787, time.UTC),
+			modTime:          time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 			uncompressedSize: 1519,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x4d\x6f\xe3\x36\x10\x3d\x93\xbf\x62\xaa\x16\x85\x04\xa8\x54\x8b\x1e\x5a\x04\xc8\x41\xeb\x78\x77\x0b\xd4\x69\xb0\xd9\xb6\x87\xa2\x87\x11\x39\x56\xd8\xf0\x0b\x24\x1d\x7b\x61\xf8\xbf\x17\x94\x15\xe7\x63\xbb\x41\xea\x03\x4d\x88\x6f\xde\xbc\x99\x79\x64\x40\x79\x8b\x23\x81\x45\xed\x38\xd7\x36\xf8\x98\xa1\xe6\xac\x22\x27\xbd\xd2\x6e\xec\xfe\x49\xde\x55\x9c\x55\xda\x97\xd5\xa7\x8a\x73\x86\x21\x40\xb5\xdf\x83\x78\xe7\xaf\x6e\x47\x38\x1c\x2a\xce\x46\xd4\xf8\x8b\xd3\x19\xaa\x51\xe7\x9b\xcd\x20\xa4\xb7\x9d\xf4\xc9\xfa\x34\xff\x7d\x97\xd4\x6d\x27\xad\xea\x0a\xb4\xd3\x4e\xe7\xc2\xf8\x22\x3a\x51\xbc\xa3\xf8\x0c\x96\xc2\xfa\x87\x1f\x3b\xe9\x87\x88\x15\x67\x38\x48\xfd\x24\x67\x26\xa7\x28\x5a\xed\xf2\xe3\x6d\x81\x75\xf9\x53\xa0\xf4\x8c\xed\xbf\xe1\x46\x0f\xa9\x93\x46\x57\x9c\xa9\xc1\xbe\x82\x7f\x0a\x50\xc3\xeb\xc9\x8d\x1f\x2b\xce\xb2\x9d\x34\xbd\x22\xc1\xac\xbd\xe1\xbc\xeb\xe0\x82\xd6\xb8\x31\xf9\xd2\x2b\x7a\xef\x2d\xc1\x5a\xef\x2c\xf1\x3b\x8c\x9f\x9d\x9c\x83\x4f\x62\xb9\x0b\xe8\xd4\xd2\xdd\xd5\xd5\x37\xef\x7f\x5b\x2d\x3b\x51\x86\x77\x89\x96\xe0\x70\x50\x55\xc3\xf9\x7a\xe3\xe4\x64\x82\xba\x81\x3d\x67\x52\x49\x38\x3b\x07\x0c\x41\xac\xf0\x96\x16\x5e\x91\xac\x1b\xce\x64\xde\x95\xef\xc7\xb1\x88\x4b\xda\xce\xe9\x16\xde\x65\xda\xe5\x09\x52\xe6\x22\x96\x0e\x07\x43\x0b\x6f\x2d\x3a\x75\xed\x63\xd6\x6e\x84\x73\x58\xa3\x49\xc4\x59\xf4\x3e\x2f\xac\x2a\x54\xdf\x1e\xf1\x33\x72\xcf\x19\xfb\x3d\xd1\x19\x3c\xfd\x55\x4f\xe4\xb6\x9c\xb1\xeb\x1b\x1f\xf3\xd9\x17\x41\xd0\x87\x00\x17\x48\xd6\x3b\xa8\x8f\x6a\x9b\x29\xee\x8a\x62\xd2\x29\x93\xcb\x57\x91\x3e\x6c\xdc\xf2\xec\xbe\x98\xcf\x4e\xde\xba\x5a\xe6\x5d\xd3\x72\x76\x38\x5a\x7e\x72\xf7\x43\xf5\x73\xe9\xfd\xf1\xe0\x54\x94\xe8\x95\x9a\xab\xa9\xef\xef\x84\x28\xcb\xc2\xaa\x42\xd8\x82\x54\xb2\x85\x99\xaf\x69\x5e\x0e\xfc\x48\x29\x3b\xca\x6f\xb5\xa1\xf4\x25\x02\xce\x66\x45\x0f\x04\xe9\x11\x70\xa6\x3f\x45\x94\x36\x38\xda\xf6\x21\xb4\x40\xbb\x72\xe1\xfb\x10\xae\x33\x66\xea\x9d\xfa\xb8\xfa\x03\x8d\x56\x98\x7d\x4c\x85\xb9\xeb\x20\x44\x0a\x18\x09\xd0\x29\x40\xa5\x60\x6d\x70\x4c\x9c\xd1\x8e\xe4\x26\xfb\x58\x3a\x22\x8d\x16\x57\x47\xd8\x1b\x4c\x54\x84\x9e\xb2\x56\xab\xbe\x6a\x9f\xdb\xb2\xe1\x8c\xe2\x14\x7a\x4f\x23\x96\xd3\x86\x8a\x85\xf4\x1a\xca\xe9\x57\xe7\xe0\xb4\x29\x76\x2c\x32\x6e\xd0\x29\x43\xb0\xd5\xf9\x06\xbe\xfe\xf9\xa7\xef\x39\x63\x01\x9d\x96\x35\xc5\xd8\x94\x19\x1d\x66\x17\x1f\x6b\xab\x8d\x1f\x47\x8a\x60\xfc\x28\x7e\x9d\xb6\x2d\xa8\x01\xd4\x60\xc5\xc5\x9b\x16\x72\x44\x49\xd7\xd9\x47\x02\xed\xc5\x9f\x51\x67\x8a\x0d\x94\x47\x42\xf4\x21\x18\x2d\x31\x6b\xef\x4a\xee\x48\x79\x13\xdd\x74\x15\x2e\x69\xbb\xfa\xf4\xc0\x5d\x08\x9b\x53\xda\x17\x5b\x59\x73\xf6\xff\xf5\xb4\xbc\x81\xba\x3c\xbf\xe2\x03\x6e\x57\x94\x12\x8e\xd4\xc2\x5f\x7f\xcf\x6f\x86\x78\x47\x8e\x92\x4e\xa7\x2c\x6d\xe9\x9a\x8f\xcd\x23\xd5\x4e\x9b\xf6\xb4\xf0\x03\xff\x37\x00\x00\xff\xff\x54\xf8\x58\xc3\xef\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x4d\x6f\xe3\x36\x10\x3d\x93\xbf\x62\xaa\x16\x85\x04\xa8\x54\x8b\x1e\x5a\x04\xc8\x41\xeb\x78\x77\x0b\xd4\x69\xb0\xd9\xb6\x87\xa2\x87\x11\x39\x56\xd8\xf0\x0b\x24\x1d\x7b\x61\xf8\xbf\x17\x94\x15\xe7\x63\xbb\x41\xea\x03\x4d\x88\x6f\xde\xbc\x99\x79\x64\x40\x79\x8b\x23\x81\x45\xed\x38\xd7\x36\xf8\x98\xa1\xe6\xac\x22\x27\xbd\xd2\x6e\xec\xfe\x49\xde\x55\x9c\x55\xda\x97\xd5\xa7\x8a\x73\x86\x21\x40\xb5\xdf\x83\x78\xe7\xaf\x6e\x47\x38\x1c\x2a\xce\x46\xd4\xf8\x8b\xd3\x19\xaa\x51\xe7\x9b\xcd\x20\xa4\xb7\x9d\xf4\xc9\xfa\x34\xff\x7d\x97\xd4\x6d\x27\xad\xea\x0a\xb4\xd3\x4e\xe7\xc2\xf8\x22\x3a\x51\xbc\xa3\xf8\x0c\x96\xc2\xfa\x87\x1f\x3b\xe9\x87\x88\x15\x67\x38\x48\xfd\x24\x67\x26\xa7\x28\x5a\xed\xf2\xe3\x6d\x81\x75\xf9\x53\xa0\xf4\x8c\xed\xbf\xe1\x46\x0f\xa9\x93\x46\x57\x9c\xa9\xc1\xbe\x82\x7f\x0a\x50\xc3\xeb\xc9\x8d\x1f\x2b\xce\xb2\x9d\x34\xbd\x22\xc1\xac\xbd\xe1\xbc\xeb\xe0\x82\xd6\xb8\x31\xf9\xd2\x2b\x7a\xef\x2d\xc1\x5a\xef\x2c\xf1\x3b\x8c\x9f\x9d\x9c\x83\x4f\x62\xb9\x0b\xe8\xd4\xd2\xdd\xd5\xd5\x37\xef\x7f\x5b\x2d\x3b\x51\x86\x77\x89\x96\xe0\x70\x50\x55\xc3\xf9\x7a\xe3\xe4\x64\x82\xba\x81\x3d\x67\x52\x49\x38\x3b\x07\x0c\x41\xac\xf0\x96\x16\x5e\x91\xac\x1b\xce\x64\xde\x95\xef\xc7\xb1\x88\x4b\xda\xce\xe9\x16\xde\x65\xda\xe5\x09\x52\xe6\x22\x96\x0e\x07\x43\x0b\x6f\x2d\x3a\x75\xed\x63\xd6\x6e\x84\x73\x58\xa3\x49\xc4\x59\xf4\x3e\x2f\xac\x2a\x54\xdf\x4e\x73\x14\x33\x72\xcf\x19\xfb\x3d\xd1\x19\x3c\xfd\x55\x4f\xe4\xb6\x9c\xb1\xeb\x1b\x1f\xf3\xd9\x17\x41\xd0\x87\x00\x17\x48\xd6\x3b\xa8\x8f\x6a\x9b\x29\xee\x8a\x62\xd2\x29\x93\xcb\x57\x91\x3e\x6c\xdc\xf2\xec\xbe\x98\xcf\x4e\xde\xba\x5a\xe6\x5d\xd3\x72\x76\x38\x5a\x7e\x72\xf7\x43\xf5\x73\xe9\xfd\xf1\xe0\x54\x94\xe8\x95\x9a\xab\xa9\xef\xef\x84\x28\xcb\xc2\xaa\x42\xd8\x82\x54\xb2\x85\x99\xaf\x69\x5e\x0e\xfc\x48\x29\x3b\xca\x6f\xb5\xa1\xf4\x25\x02\xce\x66\x45\x0f\x04\xe9\x11\x70\xa6\x3f\x45\x94\x36\x38\xda\xf6\x21\xb4\x40\xbb\x72\xe1\xfb\x10\xae\x33\x66\xea\x9d\xfa\xb8\xfa\x03\x8d\x56\x98\x7d\x4c\x85\xb9\xeb\x20\x44\x0a\x18\x09\xd0\x29\x40\xa5\x60\x6d\x70\x4c\x9c\xd1\x8e\xe4\x26\xfb\x58\x86\x28\x8d\x16\x57\x47\xd8\x1b\x4c\x54\x84\x9e\xb2\x56\xab\xbe\x6a\x9f\xdb\xb2\xe1\x8c\xe2\x14\x7a\x4f\x23\x96\xd3\x86\x8a\x85\xf4\x1a\xca\xe9\x57\xe7\xe0\xb4\x29\x76\x64\x5d\x07\x37\xe8\x94\x21\xd8\xea\x7c\x03\x5f\xff\xfc\xd3\xf7\x9c\xb1\x80\x4e\xcb\x9a\x62\x6c\xca\x8c\x0e\xb3\x8b\x8f\xb5\xd5\xc6\x8f\x23\x45\x30\x7e\x14\xbf\x4e\xdb\x16\xd4\x00\x6a\xb0\xe2\xe2\x4d\x0b\x39\xa2\xa4\xeb\xec\x23\x81\xf6\xe2\xcf\xa8\x33\xc5\x06\xca\x23\x21\xfa\x10\x8c\x96\x98\xb5\x77\x25\x77\xa4\xbc\x89\xae\x34\xaf\xf8\x7d\xf5\xe9\x81\xbb\x10\x36\xa7\xb4\x2f\xb6\xb2\xe6\xec\xff\xeb\x69\x79\x03\x75\x79\x7e\xc5\x07\xdc\xae\x28\x25\x1c\xa9\x85\xbf\xfe\x9e\xdf\x0c\xf1\x8e\x1c\x25\x9d\x4e\x59\xda\xd2\x35\x1f\x9b\x47\xaa\x9d\x36\xed\x69\xe1\x07\xfe\xef\x00\x54\xf8\x58\xc3\xef\x05\x00\x00"),
 		},
 		"/k8s": &vfsgen۰DirInfo{
 			name:    "k8s",
-			modTime: time.Date(2018, 11, 4, 21, 45, 11, 317912450, time.UTC),
+			modTime: time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 		},
 		"/k8s/.helmignore": &vfsgen۰CompressedFileInfo{
 			name:             ".helmignore",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 315820168, time.UTC),
+			modTime:          time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 			uncompressedSize: 333,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8e\xc1\x6a\x23\x31\x0c\x86\xef\x7a\x8a\x7f\x99\xcb\xee\xb0\x78\x1e\x22\xd9\xc3\x9e\x5a\x48\xc9\xb5\x78\x66\x14\x5b\x89\x47\x36\xb6\x26\x69\x7b\xe8\xb3\x97\x24\x84\xf6\xf2\x81\x3e\x24\xf1\x75\x78\xf6\x66\x5c\xb5\xc1\x32\x24\x68\xae\x8c\x4b\x64\xc5\xb8\x4a\x9a\x45\x03\x8a\x9f\x4e\x3e\x70\x73\xd4\xe1\x25\x4a\x43\x5b\x4b\xc9\xd5\x1a\x5a\xe4\x94\x10\x52\x1e\xb1\x78\x9b\xa2\x68\xf8\x8b\xca\xc9\x9b\x9c\x19\xc5\x5b\xfc\xe1\xbd\xce\xd4\x41\x39\x78\x93\xac\xf8\x5d\x2a\x1f\xe4\x8d\x67\x5c\xc4\x22\x7e\xfd\x71\x78\xd2\xf4\x8e\xac\xb7\xcb\x6b\x12\x0a\x57\x24\x51\x76\xe4\xb6\xbb\xd7\x9d\xe5\xca\xd4\x61\x93\x97\x25\x2b\xf6\x9b\x1d\x66\xa9\x8d\x5c\x10\x1b\x6e\xbc\xe7\x93\x1b\x3f\xea\x70\xe3\x43\xc4\x30\x5c\xf1\x18\xdb\x59\x87\xef\x47\xa3\x9f\x4e\x6b\xc1\x41\x12\x37\xea\x5d\xbb\x14\xea\xdd\xe8\x4f\xd4\x3b\x5b\x0a\xf5\x9f\xd4\x61\xef\xab\xe4\xb5\xe1\xff\xf6\x5f\x23\x57\x6a\x3e\xf2\x64\xe4\x64\x66\x3f\xdc\xf7\x6a\x3e\xd2\x57\x00\x00\x00\xff\xff\xbc\x5b\x94\x77\x4d\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8e\xc1\x6a\xeb\x30\x10\x45\xf7\xf3\x15\xf7\xe1\xcd\x7b\xe6\x21\x7f\x44\xd2\x45\x57\x2d\xa4\x64\x5b\x64\x7b\x22\x4d\x22\x8f\x84\x34\x4e\xda\x2e\xfa\xed\x25\x29\xa1\xdd\x1c\x98\x03\x73\x39\x1d\x9e\xbd\x19\x57\x6d\xb0\x0c\x09\x9a\x2b\xe3\x12\x59\x31\xae\x92\x66\xd1\x80\xe2\xa7\x93\x0f\xdc\x1c\x75\x78\x89\xd2\xd0\xd6\x52\x72\xb5\x86\x16\x39\x25\x84\x94\x47\x2c\xde\xa6\x28\x1a\xfe\xa3\x72\xf2\x26\x67\x46\xf1\x16\x7f\x79\xaf\x33\x75\x50\x0e\xde\x24\x2b\xfe\x96\xca\x07\x79\xe3\x19\x17\xb1\x88\x3f\xff\x1c\x9e\x34\xbd\x23\xeb\xed\xf3\x9a\x84\xc2\x15\x49\x94\x1d\xb9\xed\xee\x75\x67\xb9\x32\x75\xd8\xe4\x65\xc9\x8a\xfd\x66\x87\x59\x6a\x23\x17\xc4\x86\x1b\xbf\xf3\xc9\x8d\x1f\x75\xb8\xf1\x2e\x62\x18\xae\xb8\x9f\xed\xac\xc3\xcf\xd0\xe8\xa7\xd3\x5a\x70\x90\xc4\x8d\x7a\xd7\x2e\x85\x7a\x37\xfa\x13\xf5\xce\x96\x42\xfd\x27\x75\xd8\xfb\x2a\x79\x6d\x78\xdc\x3e\x34\x72\xa5\xe6\x23\x4f\x46\x4e\x66\xf6\x03\xf5\xce\x96\x52\xf3\x91\xbe\x06\x00\xbc\x5b\x94\x77\x4d\x01\x00\x00"),
 		},
 		"/k8s/Chart.yaml": &vfsgen۰FileInfo{
 			name:    "Chart.yaml",
-			modTime: time.Date(2018, 11, 4, 21, 45, 11, 316310336, time.UTC),
+			modTime: time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 			content: []byte("\x61\x70\x69\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x76\x31\x0a\x61\x70\x70\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x22\x31\x2e\x30\x22\x0a\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x48\x65\x6c\x6d\x20\x63\x68\x61\x72\x74\x20\x66\x6f\x72\x20\x43\x6f\x73\x6d\x6f\x73\x20\x53\x44\x4b\x20\x61\x70\x70\x0a\x6e\x61\x6d\x65\x3a\x20\x63\x6f\x73\x6d\x6f\x73\x0a\x76\x65\x72\x73\x69\x6f\x6e\x3a\x20\x30\x2e\x31\x2e\x30\x0a"),
 		},
 		"/k8s/templates": &vfsgen۰DirInfo{
 			name:    "templates",
-			modTime: time.Date(2018, 11, 4, 21, 45, 11, 317695609, time.UTC),
+			modTime: time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 		},
 		"/k8s/templates/NOTES.txt": &vfsgen۰CompressedFileInfo{
 			name:             "NOTES.txt",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 316702849, time.UTC),
+			modTime:          time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 			uncompressedSize: 430,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x90\x4f\x6b\xf2\x40\x10\xc6\xef\x7e\x8a\x07\x11\xf2\xbe\x07\xd7\xb6\x50\x0f\x01\x2f\x2d\x52\x02\x62\xc5\xfe\x81\x52\x4a\x99\xac\x13\xb3\x75\xb3\x1b\xb2\xb3\x5a\x91\x7c\xf7\x12\x85\x56\xe8\xa1\xcf\x71\x97\xe7\x37\xbf\x99\x4b\x85\x3b\x16\x48\xc9\xa0\xba\xb6\x46\x93\x18\xef\xf0\xb4\x9c\x21\xdf\xa3\x89\xce\x19\xb7\xee\xbe\x03\x43\xfb\xaa\x22\xb7\x0a\x69\x0f\x5d\xe6\xf7\x8f\xd3\x14\x99\xa0\xa2\x3d\x84\x36\x0c\x42\xc1\x3b\x54\xc6\x45\xe1\x80\xc2\x37\x47\xee\xcc\xd3\xea\x86\x2c\x39\xcd\x0d\xb2\x05\xc4\x23\x67\xd0\x96\x8c\xa5\xdc\xb2\x3a\xd1\x4e\x79\xf1\x11\x9a\x1c\x76\x24\xba\x3c\xb6\x83\x90\xc4\x00\x5f\x9c\xfb\x24\x9b\x98\xb3\x16\x8b\x35\x0b\xc2\x56\x63\xb8\xc3\xe1\x00\xe3\xb4\x8d\x2b\x46\x5f\xfb\x50\xf9\xa0\x8a\x68\xad\xa3\x8a\xfb\x50\x68\xdb\xa4\x07\xf0\x67\xed\x1b\xc1\xc3\x74\xf9\x9c\xdd\x4e\xdf\xb3\xc5\x64\xf0\xef\x17\x6b\xd8\x75\x42\x4d\x9a\x3b\xa8\x5a\xb2\x65\x0a\xac\xe6\xdf\xaf\x6d\xfb\xf7\x34\x0c\x3d\x3e\x82\x77\x35\x49\x39\x49\x0e\xea\xb4\x87\xb2\x67\xc7\x50\xc6\xad\x1b\x0e\xe1\xf5\xe2\x4d\x99\xba\x4d\xfe\x77\x82\xba\xf4\x28\x45\xea\x74\x34\x1a\xfc\x68\xa6\x57\xe3\xf1\xf5\xb8\xf7\x15\x00\x00\xff\xff\xdb\x3c\xd9\xd3\xae\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x90\x4d\x4b\xc3\x40\x10\x86\xef\xfd\x15\x2f\xa5\x10\x3d\x74\xab\x82\x3d\x04\x7a\x51\x8a\x04\x4a\x2d\xf5\x03\x44\x44\x26\xdb\x49\xb3\x76\xb3\x1b\xb2\xb3\xad\xa5\xe4\xbf\x4b\x2c\x68\xc1\x83\xbb\xb7\x19\xde\x67\x9e\x99\x4b\x85\x3b\x16\x48\xc9\xa0\xba\xb6\x46\x93\x18\xef\xf0\xb4\x9c\x21\xdf\xa3\x89\xce\x19\xb7\xee\xda\x81\xa1\x7d\x55\x91\x5b\x85\xb4\x87\xee\xcd\xef\x1f\xa7\x29\x32\x41\x45\x7b\x08\x6d\x18\x84\x82\x77\xa8\x8c\x8b\xc2\x01\x85\x6f\xba\x20\x66\x9e\x56\x37\x64\xc9\x69\x6e\x90\x2d\x20\x1e\x39\x83\xb6\x64\x2c\xe5\x96\xd5\x91\x76\xfc\x2f\x3e\x42\x93\xc3\x8e\x44\x97\xdf\xe9\x20\x24\x31\xc0\x17\xa7\x3e\xc9\x26\xe6\xac\xc5\x62\xcd\x82\xb0\xd5\x18\xee\x70\x38\xc0\x38\x6d\xe3\x8a\xd1\xd7\x3e\x54\x3e\xa8\x22\x5a\xeb\xa8\xe2\x3e\x14\xda\x36\xe9\x01\xfc\x59\xfb\x46\xf0\x30\x5d\x3e\x67\xb7\xd3\xf7\x6c\x31\x19\x9c\xfd\x61\x0d\xbb\x4c\xa8\x49\x73\x07\x55\x4b\xb6\x4c\x81\xd5\xfc\xa7\xda\xb6\xff\x4f\xc3\xd0\xe3\x23\x78\x57\x93\x94\x93\xe4\xa0\x8e\x7b\x28\x7b\x72\x0c\x65\xdc\xba\xe1\x10\x5e\x2f\xde\x94\xa9\xdb\xe4\xbc\x13\xd4\xa5\x47\x29\x52\xa7\xa3\xd1\xe0\x57\x33\xbd\x1a\x8f\xaf\xc7\xbd\xaf\x01\x00\xdb\x3c\xd9\xd3\xae\x01\x00\x00"),
 		},
 		"/k8s/templates/_helpers.tpl": &vfsgen۰CompressedFileInfo{
 			name:             "_helpers.tpl",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 316974263, time.UTC),
+			modTime:          time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 			uncompressedSize: 1042,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x93\x41\x6b\xdc\x30\x10\x85\xef\xfe\x15\x0f\xd1\x40\x9b\xb2\xce\xa1\xd0\xc3\x42\x4e\x69\x0f\xa5\x90\x42\x03\xe9\xb1\xc8\xf6\xa8\x1e\x90\x65\x57\x23\x6d\xb3\x24\xf9\xef\x45\x92\xe3\xdd\x0d\x18\xb2\xb7\xc1\x7a\x7a\xf3\xe6\x1b\xf9\xf1\xf1\xea\x12\x3b\x1e\xb6\x10\x0a\x30\x6c\x29\xec\x27\xba\x1e\xa2\x04\xdd\xf6\xb4\xc5\xe5\xd5\xf3\x73\x95\x54\xd5\xd7\x87\x49\xbb\x0e\xa1\x27\x38\x3d\x10\x46\x93\xeb\xb6\xd7\x3e\xd4\xd5\xac\xdb\xa0\x23\xc3\x8e\xa0\xda\x51\x86\x51\xea\x24\x55\xd8\x1c\x0e\x75\xb4\x01\xf5\x4d\xbe\x75\x9b\x7c\xea\x7b\x6d\x23\x15\xe5\x8f\x1d\x79\xcf\x1d\xe1\x09\xc1\x47\xd7\xe2\xf3\xa7\x5c\xf2\x70\x17\x8d\xe1\x07\xa8\xcd\xc1\x8c\x5c\x97\xeb\x12\xef\xc6\x93\x0e\x04\xbd\xf4\x30\xd1\xda\x3d\xfe\x46\x6d\xd9\x30\x75\xd0\xd3\x94\x83\xd7\xd5\x2f\x2a\xee\x59\x1f\x52\x8f\x34\x84\xa0\xa1\x56\x47\x21\xc8\x38\x10\xbe\xc7\x86\xbc\xa3\x40\x52\xc6\x35\x4c\xb6\x13\x68\x4f\xb0\x3c\x70\xa0\x0e\x61\x44\xe8\x59\xf0\xbe\xd9\x67\x14\x5f\x6e\xef\x92\x96\xdd\x1f\xc8\x44\xed\x87\xba\xfa\x66\xe0\xc9\x92\x96\x99\x59\x3b\xba\xa0\xd9\x49\xa1\x56\xbe\x71\xc0\x3f\xb6\x16\x0d\x21\x4a\xca\x29\xd0\x39\xfc\x9c\x76\x8d\x6c\x92\x9c\xd2\x65\xb3\xc0\x7c\x39\x5c\x80\xbe\x68\x56\x05\x6f\x22\x6e\xe5\xe0\xf4\x2e\xa7\xdf\x5e\xbf\x7d\xa9\x47\x39\x17\x10\xc5\xa5\xfe\x59\x28\x95\xcb\x4b\xd6\x93\xaf\x67\x07\x9c\x3c\xbb\x60\xa0\x2e\x64\x73\x21\xea\x95\x5b\xe9\x7b\xce\x33\x5b\xab\x4f\x9e\xdf\xd1\x5e\xd3\xcf\xb2\x23\x2f\x3c\xba\xb4\xd3\xbc\xdb\xf9\xa1\x14\x95\xd5\x0d\xd9\xf5\xfd\x66\x91\x5a\x9d\xe6\x18\x76\xa9\xef\xe7\x66\x4f\xf0\x34\x59\xdd\x12\xd4\x47\x05\xf5\x5b\x9d\x35\xe6\xff\x00\x00\x00\xff\xff\x0e\x39\x11\x77\x12\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x93\x41\x6b\xdb\x40\x10\x85\xef\xfa\x15\x8f\x25\x81\x36\xc5\xca\xa1\xd0\x83\x21\xa7\xb4\x87\x52\x48\xa1\x81\xf4\x58\x56\xd2\x6c\x3d\xb0\x5a\xa9\x3b\xb3\x6e\x4c\x92\xff\x5e\x76\x25\xdb\x71\xc1\xc1\xbe\x0d\xda\x6f\xde\xbc\x7d\xb3\x7a\x7a\xba\xbe\xc2\x9a\xfb\x25\x84\x14\x8e\x3d\xe9\x66\xa4\x9b\x3e\x89\xda\x76\x45\x4b\x5c\x5d\xbf\xbc\x54\x99\xaa\xbe\x3c\x8e\x36\x74\xd0\x15\x21\xd8\x9e\x30\xb8\x52\xb7\x2b\x1b\xb5\xae\x66\x6e\x81\x8e\x1c\x07\x82\x69\x07\xe9\x07\xa9\x33\x6a\xb0\xd8\x1f\xda\xe4\x15\xf5\x6d\xe9\xba\xcb\x3a\xf5\x83\xf5\x89\x26\xf2\xfb\x9a\x62\xe4\x8e\xf0\x0c\x8d\x29\xb4\xf8\xf4\xb1\x94\xdc\xdf\x27\xe7\xf8\x11\x66\xb1\x17\xa3\xd0\x95\x7a\xb2\x77\x1b\xc9\x2a\xc1\xee\x66\xb8\xe4\xfd\x06\x7f\x92\xf5\xec\x98\x3a\xd8\x71\x2c\xc6\xeb\xea\x27\x4d\xea\x85\xd7\x3c\x23\x5f\x42\xd0\x50\x6b\x93\x10\x64\xe8\x09\xdf\x52\x43\x31\x90\x92\x94\x2e\x38\x26\xdf\x09\x6c\x24\x78\xee\x59\xa9\x83\x0e\xd0\x15\x0b\xde\x35\x9b\x12\xc5\xe7\xbb\xfb\xcc\x72\xf8\x0d\x19\xa9\x7d\x5f\x57\x5f\x1d\x22\x79\xb2\x32\x67\xd6\x0e\x41\x2d\x07\x29\x03\x75\xfa\xc6\x8a\xbf\xec\x3d\x1a\x42\x92\xec\x53\x60\x8b\xf9\xd9\xed\xb1\x64\x33\x72\x98\x2e\xbb\x5d\x98\xdb\xc3\x5d\xa0\x5b\xe6\x28\x70\x52\xe2\x5e\xf6\x4a\x17\xb9\x1d\xcb\x9b\xd3\x97\xba\xed\x64\xb7\x0f\x62\x52\xa9\x7f\x4c\x29\x4d\xcd\x5b\xee\xf0\xeb\xd9\x06\xc7\xc8\x41\x1d\xcc\xa5\x2c\x2e\xc5\xfc\xa7\x76\x11\x4e\xd6\x0c\xdd\x9b\xf5\xc1\xf3\x7b\xb5\xd7\xfc\xb3\xac\x29\x0a\x0f\x21\xef\xb4\xec\x76\x7e\x28\x13\xe5\x6d\x43\xfe\xf8\x7e\x0b\x64\x8e\xde\xe6\x75\xd8\x53\xfd\x30\x0f\x7b\x46\xa4\xd1\xdb\x96\x60\x3e\x18\x98\x5f\xe6\xac\x6b\xfe\x1b\x00\x0e\x39\x11\x77\x12\x04\x00\x00"),
 		},
 		"/k8s/templates/configmap.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "configmap.yaml",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 317185974, time.UTC),
+			modTime:          time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 			uncompressedSize: 385,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\xd0\x31\x4f\xc3\x40\x0c\x05\xe0\x3d\xbf\xc2\xea\xd4\x22\xd5\x11\x8c\xd9\x10\x12\x4c\x30\x80\xc4\xee\x5c\xdc\xc6\xaa\xcf\x77\x8a\x2f\x95\x50\xe8\x7f\x47\x49\x06\x40\xd0\xd9\xfa\xde\xb3\x1e\x65\x79\xe7\xc1\x25\x59\x03\xe7\xdb\xea\x24\xd6\x35\xf0\x90\xec\x20\xc7\x67\xca\x55\xe4\x42\x1d\x15\x6a\x2a\x00\xa3\xc8\x0d\x4c\x13\x88\x05\x1d\x3b\x86\x4d\x48\x1e\x93\xe3\x61\x54\x9d\x8f\x1b\x40\xb8\x5c\xf6\x61\xd1\x91\x72\x05\xa0\xd4\xb2\xfa\xac\x01\x28\x67\x3c\x8d\x2d\x0f\xc6\x85\x1d\x25\xd5\x57\x13\xbf\xd3\x16\xd9\xb3\x46\xf4\xbe\x0e\x3d\x0d\xe5\x5f\xb0\x5c\x7e\x88\xbf\x5d\x62\x5e\xc8\xc2\xda\x87\xaf\xac\x4c\xce\xf8\x42\x91\xaf\x9b\x48\x46\x47\xee\xf6\xed\xc7\x6f\xf5\xc6\xc3\x59\xc2\x02\xd7\x71\xa6\x09\xb6\x25\x2b\x6c\xf1\x51\x94\x1d\x9f\x34\xb5\xf3\x6f\xf3\x10\xf5\xcd\x66\x87\xf7\xbe\x6e\x0a\xb8\x83\x4f\x10\xeb\xd8\x0a\xdc\xcd\x01\x5f\x01\x00\x00\xff\xff\x38\x2b\x5f\xaf\x81\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\xd0\x41\x4b\xc4\x30\x10\x05\xe0\x7b\x7f\xc5\xa3\xa7\x5d\x61\xa7\xe8\xb1\x37\x11\xf4\xa4\x07\x05\xef\xd3\x64\x76\x1b\x36\x99\x84\x26\x5d\x90\xda\xff\x2e\x6d\x0f\x2a\xba\xe7\xc7\xf7\x92\x79\x9c\xdc\xbb\x0c\xd9\x45\x6d\x71\xb9\xad\xce\x4e\x6d\x8b\x87\xa8\x47\x77\x7a\xe6\x54\x05\x29\x6c\xb9\x70\x5b\x01\xca\x41\x5a\x4c\x13\x9c\x1a\x3f\x5a\x41\x6d\x62\x0e\x31\xd3\x71\xf4\x7e\x09\x6b\x10\xe6\xf9\x60\x56\x1d\x38\x55\x80\xe7\x4e\x7c\x5e\x34\xc0\x29\xd1\x79\xec\x64\x50\x29\x92\xc9\xc5\xe6\x6a\xe3\x77\xdb\x2a\x7b\xf1\x81\x72\xdf\x98\x9e\x87\xf2\x2f\x58\x93\x1f\xe2\xef\x5b\x4e\x73\x61\x35\xdb\x05\xf4\x2a\x5e\x38\x0b\xbd\x70\x90\xeb\x26\xb0\xf2\x49\xec\xa1\xfb\xf8\xad\xde\x64\xb8\x38\xb3\xc2\x6d\x9c\x69\xc2\xae\x24\x8f\x1d\x3d\x3a\x2f\x99\x9e\x7c\xec\x96\xbf\x2d\x43\x34\x37\xf5\x9e\xee\xf3\xb6\x29\x68\x8f\x4f\x38\xb5\xa2\x05\x77\x98\xe7\xea\x6b\x00\x38\x2b\x5f\xaf\x81\x01\x00\x00"),
 		},
 		"/k8s/templates/service.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "service.yaml",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 317391293, time.UTC),
+			modTime:          time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 			uncompressedSize: 545,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x90\xbd\x6a\xeb\x40\x14\x84\x7b\x3d\xc5\xe0\xde\x6b\xee\x85\xa8\xd8\x32\x69\x43\x30\x49\x48\x7f\xb4\x9a\x58\x8b\xf7\x8f\xdd\x95\xc1\x18\xbf\x7b\x90\xac\x22\xc6\x71\x11\x52\x9e\x39\x7c\x33\xcc\x48\xb2\x1f\xcc\xc5\xc6\xa0\x71\xf8\xd7\xec\x6d\xe8\x35\xde\x98\x0f\xd6\xb0\xf1\xac\xd2\x4b\x15\xdd\x00\x41\x3c\x35\x4e\x27\xd8\x60\xdc\xd8\x13\x2b\x13\x8b\x8f\x45\x7d\x8e\xce\x4d\xcf\x15\x14\xce\xe7\x06\x70\xd2\xd1\x95\x89\x01\x24\x25\xb5\x1f\x3b\xe6\xc0\xca\xa2\x6c\xdc\xdc\xf5\xb9\xf2\x00\x06\x3a\xaf\xca\xb0\x31\x83\xe4\xfa\x23\x30\x7f\xbe\x11\xb7\x59\x36\x94\x2a\xc1\x5c\xf2\xd4\x2b\x1d\xa5\x50\xbd\x88\xe7\x7d\xc6\x4b\x90\x1d\xfb\x75\x77\xbc\xa6\x96\x4d\x26\xb0\x24\x9a\xa9\x5e\x3d\x26\x6a\x3c\x47\xe9\x1f\xc5\x4d\x39\xb9\x01\x52\xcc\x75\x29\xbf\x9e\x0f\x8d\xff\x6d\xfb\xd0\xce\x0a\x50\x25\xef\x58\xb7\x37\x7a\xca\xb1\x46\x13\x9d\xc6\xfb\xd3\x76\xd1\x2e\x53\x25\xce\xbe\x85\x8e\xa6\xc6\xfc\xd7\x5d\x7f\xbb\xd2\x57\x00\x00\x00\xff\xff\x62\xee\xcd\x6f\x21\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x90\xcd\x4a\x03\x31\x14\x85\xf7\xf3\x14\x87\xee\x9b\xa2\x60\x17\x59\xea\x56\xa4\xa8\xb8\xbf\xcd\x1c\x3b\xa1\xf9\x23\xc9\x14\x4a\xe9\xbb\x4b\xc6\x59\x58\x6a\x17\xe2\x32\xe7\xf2\x9d\x9b\xfb\x49\xb2\x1f\xcc\xc5\xc6\xa0\x71\xb8\xeb\xf6\x36\xf4\x1a\x6f\xcc\x07\x6b\xd8\x79\x56\xe9\xa5\x8a\xee\x80\x20\x9e\x1a\xa7\x13\x6c\x30\x6e\xec\x89\x85\x89\xc5\xc7\xa2\x3e\x47\xe7\xda\x70\x01\x85\xf3\xb9\x03\x9c\x6c\xe9\x4a\x63\x00\x49\x49\xed\xc7\x2d\x73\x60\x65\x51\x36\xae\x6e\xf6\x5c\x74\x00\x03\x9d\x57\x65\x58\x99\x41\x72\xfd\x15\x98\x26\x3f\x88\xeb\x5d\x36\x94\x2a\xc1\x7c\xff\x5b\xbd\xd2\x51\x0a\xd5\x8b\x78\xde\x66\xbc\x04\xd9\xb1\x5f\x6e\x8f\x97\xd4\xec\xa4\x81\x25\xd1\xb4\xf3\xea\x31\x51\xe3\x39\x4a\xff\x28\xae\xed\xc9\x1d\x90\x62\xae\xf3\xf1\xcb\xe9\xa1\x71\xbf\x5e\x3f\xac\xa7\x04\xa8\x92\x77\xac\x9b\xab\x3c\xe5\x58\xa3\x89\x4e\xe3\xfd\x69\x33\x67\xcd\x88\x46\xe2\xd4\x5b\xe8\x68\x6a\xcc\xff\xf5\xfa\x57\x4b\x5f\x03\x00\x62\xee\xcd\x6f\x21\x02\x00\x00"),
 		},
 		"/k8s/templates/statefulset.yml": &vfsgen۰CompressedFileInfo{
 			name:             "statefulset.yml",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 317603393, time.UTC),
+			modTime:          time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 			uncompressedSize: 2562,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x4f\x6f\xda\x4e\x10\xbd\xf3\x29\x46\x9c\x7f\x36\x4a\xa4\x5f\x5a\xf9\x4a\xa5\x5e\x9a\x14\x85\x34\x55\x55\xf5\x30\xac\x07\xbc\xca\xfe\xeb\xee\x98\x8a\x52\x7f\xf7\x6a\xb1\x01\xdb\x31\x2a\x05\xd5\xc7\x99\x7d\x6f\x66\xde\xbc\xf5\xa2\x93\xcf\xe4\x83\xb4\x26\x03\x74\x2e\x4c\xd6\x37\x0b\x62\xbc\x1d\xbd\x48\x93\x67\x30\x67\x64\x5a\x96\x6a\x4e\x3c\xd2\xc4\x98\x23\x63\x36\x02\x30\xa8\x29\x83\xed\x16\xa4\x11\xaa\xcc\x09\xc6\xc2\x06\x6d\x43\xba\x2c\x95\x8a\xc9\x31\xa4\x50\x55\x23\x00\x85\x0b\x52\x21\x62\x20\x16\x48\x5f\xca\x05\x79\x43\x4c\x21\x95\x76\x72\x92\xa7\xc3\x01\x50\x90\xd2\x69\x28\x26\xa2\x40\xcf\x83\x80\x5d\xa6\x85\x78\x5d\x4b\x9a\xc0\x68\x44\x5d\x2f\x7d\x24\x45\x18\x28\x7d\x40\x4d\xa7\x31\x1a\x0d\xae\x28\x4f\x16\x9b\x2e\x6a\x4e\x7e\x2d\xc5\x0e\x18\x1c\x89\x38\x9e\x27\xa7\xa4\xc0\x50\x1f\x7c\x46\x55\x52\x48\x9b\xe0\xd4\x96\x86\xeb\x2a\xa5\xcb\x91\x69\xce\x1e\x99\x56\x9b\x5a\x17\xde\x38\xca\xe0\xd1\x2a\x25\xcd\xea\xd3\xee\xc0\x08\x20\x90\x22\xc1\xd6\xd7\x67\x34\xb2\x28\x3e\xb4\xc4\xbc\x46\xce\x4b\xe4\x59\x5b\x55\x6a\x9a\x2a\x94\xfa\x89\xb4\x53\xc8\xd4\x74\x92\x40\xdb\x1a\xf5\x57\x77\x12\x63\x49\x0d\x3c\x64\x54\x67\x86\x6b\xe7\xb8\xcc\x1c\x97\x6a\x70\x1a\x79\x86\x51\x6a\xe8\xde\x2e\x0d\x91\x10\x14\xc2\xbd\xcd\xa9\xa3\x48\x02\x8f\x84\xf9\x67\x2f\x99\x3e\x1a\x71\xd4\xce\x53\xb0\xa5\x17\xdd\xc3\x9e\xbe\x97\x14\xb8\x13\x03\x08\x6c\x3d\xae\xa8\xe3\xc6\x26\x96\x06\xf9\xb3\x33\x50\x13\x9f\x2a\x0c\xe1\xe1\xbc\x9b\x9d\x34\x18\x11\x31\x23\x00\x6e\x2c\xd1\x98\xb5\xe7\x87\xfe\xce\xaf\xdb\xf8\x25\x7b\x6b\xcb\x5e\x1b\xb2\xd5\x4e\xd2\xd8\x55\x58\xb3\x94\xab\xbe\x61\xa1\x89\xdf\xa3\xeb\x4a\x7c\xe6\x4f\x30\xa9\xe1\x1a\xdd\xe8\x40\xc7\x28\x0d\xf9\x81\x16\x62\xff\xd3\xe8\xd6\x01\xd7\x49\xbd\x5b\xe8\xb8\xb5\xd1\x5d\x28\xfe\x65\x6c\x90\x6c\xfd\x06\xaa\x2a\x7b\x95\x66\x5c\x41\x55\x8d\xfb\x4c\xb3\x52\xa9\x99\x55\x52\x6c\x3a\x26\xa9\x31\xee\x90\xec\x39\xdf\xaf\x42\x06\x5f\xdb\x3d\x08\xab\x35\x9a\x3c\x96\xf8\x0f\xc6\x81\xe3\x55\xfb\xd6\x82\x38\xeb\xfb\xe6\xdc\x4f\xeb\x88\x7c\x27\xd1\x12\x67\x66\xe3\x65\xbe\xbd\xbb\xfb\xff\xae\x77\xc4\x79\xcb\x56\x58\x95\xc1\xd3\x74\x36\xc8\xeb\x9d\xf8\x33\xed\x9b\x33\x69\x95\x5c\x93\xa1\x10\x66\xde\x2e\xa8\x3b\x47\xc1\xec\xde\x13\x67\x7d\x26\xe4\x22\x83\x49\x41\xa8\xb8\xe8\xe7\x06\xeb\x7b\xc2\x5c\xfe\xeb\x22\xb5\xaf\xef\xe3\x5b\x74\x62\x1f\xa7\x2e\x40\xfc\x74\xc4\xcd\x76\x45\xdb\x0f\x9c\xb5\xfc\x4e\x7a\xa8\xaa\x49\x0d\x1e\xe4\x1d\x7a\x07\xce\x64\x8d\xd0\x8e\x50\x87\x3f\xe0\x76\x0b\x6c\xbf\xa0\x56\xad\xd7\xb6\x49\xc2\x2f\x90\x26\x27\xc3\x70\x73\xbb\xf7\xef\x76\x9b\xc0\x0f\xc9\xc5\xe1\xb4\xb1\x39\xcd\x9b\x37\xf6\x68\xf2\x76\xb4\x53\xe3\xc8\xf9\xb6\x4d\x49\x3b\xe7\x0f\x57\xc0\xe5\x52\x1a\xc9\xad\x2b\xb4\x8f\x5c\xcb\xcc\x56\x91\x47\x96\xd6\x84\x23\x79\x2b\xf8\x17\xfc\xbf\x03\x00\x00\xff\xff\x7f\x61\x20\x91\x02\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x4d\x6f\xda\x40\x10\xbd\xf3\x2b\x46\x9c\x8b\x51\x22\x35\xad\x7c\xa5\x52\x2f\x4d\x8a\x42\x9a\xaa\xaa\x7a\x18\xd6\x03\x5e\x65\xbf\xba\x3b\xa6\xa2\xd4\xff\xbd\x5a\x6c\x87\x35\x01\x85\x82\x2a\x9f\x3c\x33\xef\xcd\xd7\xdb\x5d\x74\xf2\x91\x7c\x90\xd6\xe4\x80\xce\x85\xf1\xea\x6a\x4e\x8c\xd7\x83\x27\x69\x8a\x1c\x66\x8c\x4c\x8b\x4a\xcd\x88\x07\x9a\x18\x0b\x64\xcc\x07\x00\x06\x35\xe5\xb0\xd9\x80\x34\x42\x55\x05\xc1\x50\xd8\xa0\x6d\xc8\x16\x95\x52\xd1\x39\x84\x0c\xea\x7a\x00\xa0\x70\x4e\x2a\x44\x0c\xc4\x04\xd9\x53\x35\x27\x6f\x88\x29\x64\xd2\x8e\x8f\xf2\xf4\x38\x00\x4a\x52\x3a\x0b\xe5\x58\x94\xe8\xf9\x20\x60\xeb\x49\x10\x2f\x73\x49\x13\x18\x8d\x68\xea\xce\xee\x49\x11\x06\xca\xee\x50\xd3\x71\x8c\x46\x83\x4b\x2a\x46\xf3\x75\x1f\x35\x23\xbf\x92\x62\x0b\x0c\x8e\x44\x6c\xcf\x93\x53\x52\x60\x68\x02\x1f\x51\x55\x14\xb2\xd6\x38\xb1\x95\xe1\x18\x0c\x50\xb9\x02\x99\x66\xec\x91\x69\xb9\x8e\x40\x00\x5e\x3b\xca\xe1\xde\x2a\x25\xcd\xf2\xcb\x36\x60\x00\x10\x48\x91\x60\xeb\x9b\x18\x8d\x2c\xca\x4f\xc9\x30\x2f\x19\xe7\x39\xe3\x59\x59\x55\x69\x9a\x28\x94\xfa\x81\xb4\x53\xc8\xd4\x56\x32\x82\x54\x1a\xcd\xd7\x54\x12\x6d\xa3\x06\xf8\xec\x49\x05\x71\x79\x1f\xe7\x89\xe3\xdc\x19\x1c\x47\x9e\x20\x94\x08\x04\xe8\xe4\xd2\x12\x09\x41\x21\xdc\xda\xa2\x9b\x65\xf3\x8d\xe0\x9e\xb0\xf8\xea\x25\xd3\x67\x23\x76\xb3\xf3\x14\x6c\xe5\x45\x3f\xd8\xd3\xcf\x8a\x02\xf7\x6c\x00\x81\xad\xc7\x25\xf5\xd4\xd8\xda\xb2\x20\x7f\xf7\x1a\x6a\xed\x13\x85\x21\xdc\x9d\x76\xb2\x47\x2d\x46\x44\xcc\x00\x80\x5b\x49\xb4\x62\xdd\xd3\xc3\xfe\xce\x2f\xdb\xf8\x39\x7b\x4b\xc7\xde\x08\x32\x29\x67\xd4\xca\x55\x58\xb3\x90\xcb\x7d\xc1\x42\x6b\xbf\x45\xb7\x83\xec\x34\xfe\xfa\xa8\x1a\xb8\x46\xd7\xa2\x85\x35\x8c\xd2\x90\x3f\x50\x42\xac\x7f\x12\xaf\xb2\x03\xaa\x93\x7a\xbb\xd0\x61\xb2\xd1\xad\x29\xde\x32\x36\x48\xb6\x7e\x0d\x75\x9d\xbf\x70\x33\x2e\xa1\xae\x87\xfb\x4c\xd3\x4a\xa9\xa9\x55\x52\xb4\x92\xed\x61\xdc\xb3\xb3\x5f\x03\xfa\x65\xc8\xe1\x7b\x5a\x83\xb0\x5a\xa3\x29\x62\x8a\x37\x30\x0c\x1c\x8f\xda\x8f\x04\xe2\xac\xdf\x17\x67\xd7\xad\x23\xf2\x3d\x47\x32\x9c\xa9\x8d\x37\xfd\xf5\xcd\xcd\xdb\x9b\xbd\x10\xe7\x2d\x5b\x61\x55\x0e\x0f\x93\xe9\x41\x5e\xef\xc4\xeb\xb4\xef\x4e\xa4\x55\x72\x45\x86\x42\x98\x7a\x3b\xa7\x7e\x1f\x25\xb3\xfb\x48\xdc\x37\x02\x38\xe4\x32\x87\x71\x49\xa8\xb8\xdc\xf7\x1d\xcc\xef\x09\x0b\xf9\xbf\x93\x34\xba\xbe\x8d\x6f\xd1\x91\x7d\x1c\x3b\x00\xf1\xd3\x11\x37\xdd\x76\x96\x2c\xdf\x5b\xcb\x1f\xa4\x87\xba\x1e\x37\xe0\x83\xbc\x87\xde\x81\x13\x59\x23\x34\xc1\x24\x37\xe0\x66\x03\x6c\xbf\xa1\x56\x3b\x58\xe7\x84\x3f\x20\x4d\x41\x86\xe1\xea\xba\xd3\xef\x66\x33\x82\x5f\x92\xcb\xe7\x68\x63\x0b\x9a\xb5\x6f\xec\x4e\xe4\xa9\xb5\x97\x63\xc7\xf9\x3e\xa5\x24\x53\xa4\xbf\xbd\x0c\xb8\x58\x48\x23\x79\xdd\x05\x00\x74\x96\x4b\x99\xd9\x2a\xf2\xc8\xd2\x9a\xd0\xc5\x00\x24\xc6\x7f\xe0\xff\x3b\x00\x7f\x61\x20\x91\x02\x0a\x00\x00"),
 		},
 		"/k8s/templates/storageclass.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "storageclass.yaml",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 317820289, time.UTC),
+			modTime:          time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 			uncompressedSize: 556,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x91\xbd\x4e\x03\x31\x10\x84\xfb\x7b\x8a\x55\xfa\x38\xa2\x43\xd7\x22\x5a\x0a\x82\xd2\x6f\x7c\x43\x62\x65\xfd\x23\xaf\x1d\x08\xd1\xbd\x3b\x3a\x1f\x3f\x41\x24\xed\xcd\x7c\xdf\x9e\xc6\x9c\xdc\x06\x59\x5d\x0c\x3d\x69\x89\x99\x77\x30\x87\x7b\x35\x2e\xae\x8e\x77\xdd\xc1\x85\xa1\xa7\xf5\xfc\xfd\x41\x58\xb5\xf3\x28\x3c\x70\xe1\xbe\x23\x0a\xec\xd1\xd3\xf9\x4c\x2e\x58\xa9\x03\x68\x61\xa3\xfa\xa8\xe6\xb5\x8a\x4c\xe1\x82\x0c\x8d\xe3\xf2\x4b\x6c\x9b\x80\x48\x78\x0b\xd1\x49\x40\xc4\x29\x99\x43\xdd\x22\x07\x14\xb4\xb3\x37\xa5\xbf\xc2\x46\xee\x21\xde\xe8\x7e\x65\xf7\x9c\xcb\x55\xa0\x25\x17\xc4\xff\x5b\x2e\x68\xe1\x60\xe7\x7b\xe6\x19\x02\x56\x98\x27\xf6\xb8\xcd\x78\x0e\xbc\xc3\xb0\xdc\x9e\xfe\x52\x6b\xe4\xa3\xb3\x0d\x4c\x39\x1e\xdd\xb4\x29\xf2\xdc\xd9\xb0\x54\xa8\xf9\x1e\xf8\x22\x9f\xea\x2c\x12\xdf\x36\x51\xaa\xc7\xe3\x7b\xe2\x30\xbf\x46\xc9\x15\x5d\xe2\xcc\x1e\x05\xb9\xcd\x55\x4e\x09\x57\x85\x53\x30\xff\x71\x46\x12\x67\xb9\xb8\x18\x96\x37\xeb\x17\xa5\x97\x1f\xf2\x23\x06\xe8\xd5\x7a\x4b\x68\x1c\x3f\x03\x00\x00\xff\xff\xca\x8a\xe7\x09\x2c\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\x3d\x6f\x32\x31\x10\x84\xfb\xfb\x15\x2b\x7a\x8c\xde\xee\xd5\xb5\x51\xda\x14\x21\xa2\x5f\x7c\x13\xb0\x58\x7f\xc8\xeb\x23\x01\x74\xff\x3d\xb2\xc9\x07\x51\xb8\xd6\x33\xcf\xb3\xf2\x70\x72\x1b\x64\x75\x31\xf4\xa4\x25\x66\xde\xc1\x1c\xfe\xab\x71\x71\x75\xfc\xd7\x1d\x5c\x18\x7a\x5a\x5f\xdf\x1f\x84\x55\x3b\x8f\xc2\x03\x17\xee\x3b\xa2\xc0\x1e\x3d\x5d\x2e\xe4\x82\x95\x71\x00\x2d\x6c\x54\x1f\xd5\xbc\x8e\x22\x35\x5c\x90\xa1\x69\x5a\x7e\x8a\x6d\x13\x10\x09\x6f\x21\x5a\x05\x44\x9c\x92\x39\x8c\x5b\xe4\x80\x82\x76\x76\x56\xfa\x23\x6c\xe4\x1e\xe2\x8d\xee\x57\x76\xcf\xb9\xdc\x05\x5a\x72\x43\xfc\xbd\xe5\x82\x16\x0e\xf6\xfa\x09\xf3\x0c\x01\x2b\xcc\x13\x7b\xcc\x33\x9e\x03\xef\x30\x2c\xb7\xa7\xdf\xd4\x1a\xf9\xe8\x6c\x03\x53\x8e\x47\x57\x37\x45\xbe\x76\x36\x2c\x23\xd4\x7c\x0d\x7c\x93\xd7\x3a\x8b\xc4\xb7\x4d\x94\xd1\xe3\xf1\x3d\x71\xa8\x64\x4f\x25\x8f\xe8\x12\x67\xf6\x28\xc8\x6d\xae\x72\x4a\xb8\x2b\xac\x41\x35\x11\x65\x24\x71\x96\x8b\x8b\x61\x39\x5b\xbf\x29\xbd\x7c\x93\xe7\x18\xa0\x77\xeb\xe7\x18\xa0\x34\x4d\x1f\x03\x00\xca\x8a\xe7\x09\x2c\x02\x00\x00"),
 		},
 		"/k8s/values.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "values.yaml",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 318033678, time.UTC),
+			modTime:          time.Date(2024, 9, 14, 22, 39, 18, 0, time.UTC),
 			uncompressedSize: 906,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\xb1\x6e\x1b\x49\x0c\xed\xe7\x2b\x08\x5f\xe1\x3b\xc0\xd2\x59\xae\x82\x6d\x6d\xc0\x70\xe1\xc4\xb0\x0c\xa4\x08\x52\x8c\x67\xb9\x12\xe3\x59\x72\x43\x72\x24\xac\x83\xfc\x7b\x30\x23\xc5\x4a\x90\x26\x15\x97\xcb\xf7\x1e\xf9\x38\xe4\x38\xe2\x87\x1d\xaa\x52\x8f\x1d\x9c\x9d\x85\xa1\xe4\xfc\xc7\xcf\xa0\x38\x65\x4a\xf1\x5a\x0a\x7b\x07\xab\x10\x92\x8c\x63\xe4\xbe\x55\x55\xc4\x6f\x48\x0f\x48\x1a\xe3\x06\xbb\x00\xa0\x38\x89\x91\x8b\xce\x35\xf3\xb8\xe9\x20\x47\x47\xf3\x00\x30\x95\x9c\x1f\x24\x53\x9a\x3b\xb8\x1b\xde\x8b\x3f\x28\x1a\xb2\x87\x60\x2e\x7a\x14\xf8\x07\x6e\xaf\x1f\x60\x52\xd9\x91\x91\x30\x6a\x25\x9e\xb2\x0e\x5e\xca\x33\x2a\xa3\xa3\x2d\x49\xfe\xdf\x24\x5c\x4c\x7d\xe3\xad\x3d\x72\x1f\xb5\x87\x7f\x59\x78\xb1\x5e\xdf\xfc\x07\x3d\xd9\x4b\x1d\x63\x9e\xb0\x83\xa9\x5f\xd8\x11\xd2\xf0\x8f\xb8\x21\xe1\x98\xe1\xe6\x80\x3a\xba\x75\x12\x7e\x6a\x04\x3d\x02\x7e\x36\x78\x3c\x01\xe0\x55\x18\x2d\xc0\x21\x76\x50\x6c\xb1\x47\xf3\xd5\x22\x5e\x9c\xbe\x9f\x1b\xab\xaa\x83\xd1\x2b\x06\x68\xa1\x83\xab\x5b\xaa\xbb\x35\x29\x9a\x2a\xf9\xdb\xf7\x06\xfc\x88\x50\xac\xc4\x9c\x67\x50\xac\x9b\x46\xee\x81\xc5\xc1\x05\x6c\xc2\x44\xc3\x0c\x3d\x0e\xb1\x64\x87\x37\x36\x44\xee\x2b\x20\x63\xdc\x21\xf8\x96\x0c\xa2\x41\x84\x24\x6c\x89\xa4\x58\x93\x4e\x5b\xa1\x84\x30\x88\x82\x6f\x6b\x1b\xd4\x25\x3c\x35\x70\x36\x01\xe2\xa4\x18\x0d\x0d\xd2\x36\x72\x3a\x44\x75\x03\x2d\x0c\xc2\x80\xbc\x23\x15\x1e\x91\xdd\x60\x4f\xbe\x85\x4c\xee\x19\x9b\xf6\xdb\x28\x17\x60\x25\x6d\x6b\xfb\x7b\x62\xaa\xcf\xb4\x84\xbb\x01\x66\x29\xd0\x0b\xec\x23\xff\xe6\xe4\x17\x5a\xe1\x83\x5b\x6f\xc3\x0d\x92\xb3\xec\x89\x37\x4d\x3d\x13\x57\x48\xec\xbf\x14\x6b\xf5\xb1\x36\x60\x4c\x68\x16\x75\xbe\x68\xfe\x15\x47\x69\xee\x11\x52\xd1\x3c\xc3\xb3\xc6\xb6\x9b\xc1\x51\xe1\xfc\xb4\xea\xf3\xe5\x51\x74\x24\xb7\xc3\xb5\x41\x9a\x4a\x07\xab\xcb\xcb\xf1\x90\x8e\x38\xd6\xe3\x85\xd5\xd5\xbb\x7b\x3a\x1a\xfc\x5a\xd0\xfe\x0e\x1f\x58\x7a\x5c\x63\xc6\xe4\xa2\xed\x65\x83\x4b\x46\x6d\x47\x63\x1d\x7c\xfa\x1c\x42\x1c\x06\x62\xf2\xb9\x95\x7f\x04\x00\x00\xff\xff\x53\xd8\xf8\x00\x8a\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\xcf\x4f\x2b\x37\x10\xbe\xfb\xaf\xf8\x44\x0e\xb4\x12\x49\x09\xa7\x6a\xaf\x20\x21\x0e\xb4\x88\x20\xf5\x50\xf5\x60\xbc\xb3\x89\x8b\x77\x66\x3b\x63\x27\x5a\x10\xff\x7b\x65\x27\x0f\x78\x7a\x97\x77\xb2\x67\xfd\xfd\x98\x6f\x67\xd8\x8f\xf4\xe7\x9e\x54\x63\x4f\x1d\xce\xce\xdc\x50\x52\xfa\xe1\xa3\x53\x9a\x52\x0c\xfe\x5a\x0a\xe7\x0e\x6b\xe7\x82\x8c\xa3\xe7\xbe\xbd\xaa\x48\xbe\x89\xda\xee\x2e\x8e\x7e\x4b\x9d\x03\x94\x26\xb1\x98\x45\xe7\x5a\x65\xbf\xed\x90\x7c\x26\xcb\x0e\x98\x4a\x4a\x0f\x92\x62\x98\x3b\xdc\x0d\x7f\x48\x7e\x50\x32\xe2\xec\x9c\x65\xd1\x93\xc0\x02\xb7\xd7\x0f\x98\x54\xf6\xd1\xa2\x30\xa9\xc3\xd7\xaa\xc3\x4b\x79\x26\x65\xca\x64\xab\x28\xbf\x6d\x03\x2d\xa7\xbe\xf1\x36\xd9\x73\xef\xb5\xc7\x2f\x2c\xbc\xdc\x6c\x6e\x7e\x45\x1f\xed\xa5\xb6\x31\x4f\xd4\x61\xea\x97\x76\x82\x34\xfc\x23\x6d\xa3\xb0\x4f\xb8\x39\xa2\x4e\x69\x73\x14\x7e\x6a\x04\x3d\x01\xbe\x19\x3c\x7e\x02\xf0\x2a\x4c\xe6\x70\x3c\x3b\x14\x5b\x1e\xc8\xf2\x7a\xe9\x2f\x3e\xef\xcf\xcd\xa6\xaa\xc3\xe2\x2b\x39\xb4\xa3\xc3\xd5\x6d\x74\x4e\xc9\xa4\x68\xa8\xe4\xb7\xf7\x06\xfc\x8b\x50\xac\xf8\x94\x66\x28\xd5\x3f\x4d\xdc\x83\x25\x23\x0b\x6c\xa2\x10\x87\x19\x3d\x0d\xbe\xa4\x8c\x0f\x36\x3c\xf7\x15\x90\xc8\xef\x09\x79\x17\x0d\xde\xe0\x11\x84\x2d\x44\x29\xb5\xc7\x05\xc2\x4e\x62\x20\x0c\xa2\xc8\xbb\x6a\x43\xba\xc2\x53\x03\x27\x13\x44\x0e\x4a\xde\xc8\x10\x76\x9e\xab\x6a\xd8\x79\xcd\x06\x2d\x0c\x61\x10\xef\xa3\x0a\x8f\xc4\xd9\x70\x88\x79\x87\x14\x73\x4e\x35\xd1\xe2\xb3\x95\x0b\x58\x09\xbb\x6a\x7f\x1f\x39\xd6\x31\xad\x70\x37\x60\x96\x82\x5e\x70\xf0\xfc\x5d\x92\x2f\xb4\xc2\xc7\xb4\xb9\x35\x37\x48\x4a\x72\x88\xbc\x6d\xea\x29\x72\x55\xf6\xfd\xbf\xc5\xda\xfb\x58\x0d\x98\x02\x99\x79\x9d\x2f\x5a\x7e\xa5\x51\x5a\x7a\x42\x28\x9a\x66\x3c\xab\xaf\x29\xfc\x90\x49\x71\xfe\x61\xd5\x9d\xaf\x4e\xa2\x63\xcc\x56\x17\x74\x01\x84\xa9\x74\x58\x5f\x5e\x8e\xc7\x72\xa4\xb1\x2e\x2f\xd6\x57\xbf\xdf\xc7\x53\xc0\xff\x0a\xd9\xcf\xe1\x1d\x4b\x4f\x1b\x4a\x14\xb2\x68\x9b\xac\xcb\x92\x48\xdb\xd2\x58\x87\xbf\xff\x71\xce\x0f\x43\xe4\x98\xe7\x0e\x6f\xef\xee\xff\x01\x00\x53\xd8\xf8\x00\x8a\x03\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
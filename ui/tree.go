@@ -4,19 +4,21 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 
 	"github.com/xlab/treeprint"
 )
 
-// Tree prints a source tree.
+// Tree prints a source tree. Entries matching any of the ignore names or
+// shell patterns (as understood by filepath.Match) are skipped.
 func Tree(p string, ignore []string) error {
 	root := treeprint.New()
 	root.SetValue(p)
 	if err := walk(p, root, ignore); err != nil {
 		return err
 	}
-	Verbose(strings.TrimSpace(root.String()))
+	Verbose("%s", strings.TrimSpace(root.String()))
 	return nil
 }
 
@@ -26,6 +28,9 @@ func walk(p string, node treeprint.Tree, ignore []string) error {
 			if f.Name() == i {
 				return true
 			}
+			if matched, err := filepath.Match(i, f.Name()); err == nil && matched {
+				return true
+			}
 		}
 		return false
 	}
@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/xlab/treeprint"
+)
+
+func TestTreeIgnore(t *testing.T) {
+	root, err := ioutil.TempDir("", "tree-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	for _, dir := range []string{"k8s", ".git", "src"} {
+		if err := os.Mkdir(path.Join(root, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, file := range []string{"main.go", "build.tmp", "k8s/deploy.yml", ".git/HEAD", "src/app.go", "src/app.tmp"} {
+		if err := ioutil.WriteFile(path.Join(root, file), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		ignore  []string
+		shown   []string
+		ignored []string
+	}{
+		{
+			name:    "none",
+			shown:   []string{"main.go", "build.tmp", "k8s", "deploy.yml", ".git", "HEAD", "app.go", "app.tmp"},
+			ignored: []string{},
+		},
+		{
+			name:    "exact",
+			ignore:  []string{"k8s", "main.go"},
+			shown:   []string{"build.tmp", ".git", "app.go"},
+			ignored: []string{"k8s", "deploy.yml", "main.go"},
+		},
+		{
+			name:    "glob",
+			ignore:  []string{"*.tmp", ".*"},
+			shown:   []string{"main.go", "k8s", "deploy.yml", "app.go"},
+			ignored: []string{"build.tmp", "app.tmp", ".git", "HEAD"},
+		},
+		{
+			name:    "invalid pattern",
+			ignore:  []string{"[", "k8s"},
+			shown:   []string{"main.go", "app.go"},
+			ignored: []string{"k8s"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := treeprint.New()
+			if err := walk(root, tree, tt.ignore); err != nil {
+				t.Fatal(err)
+			}
+			out := tree.String()
+			for _, name := range tt.shown {
+				if !strings.Contains(out, name) {
+					t.Errorf("%s not shown:\n%s", name, out)
+				}
+			}
+			for _, name := range tt.ignored {
+				if strings.Contains(out, name) {
+					t.Errorf("%s not ignored:\n%s", name, out)
+				}
+			}
+		})
+	}
+}
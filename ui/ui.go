@@ -1,56 +1,163 @@
 package ui
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strings"
-	"unicode/utf8"
+	"time"
 
 	"github.com/mitchellh/colorstring"
 	spin "github.com/tj/go-spin"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
+// Format is the output format.
+type Format int
+
+const (
+	// FormatText prints human friendly, colored, messages.
+	FormatText Format = iota
+	// FormatJSON prints one JSON object per message.
+	FormatJSON
+)
+
+// Level is the verbosity level.
+type Level int
+
+const (
+	// LevelQuiet only prints errors.
+	LevelQuiet Level = iota
+	// LevelNormal prints regular messages. This is the default.
+	LevelNormal
+	// LevelVerbose also prints verbose messages.
+	LevelVerbose
+	// LevelDebug also prints debug messages.
+	LevelDebug
+)
+
 var (
 	spinner  = spin.New()
 	colorize = colorstring.Colorize{
 		Colors: colorstring.DefaultColors,
 		Reset:  true,
 	}
+	format       = FormatText
+	level        = LevelNormal
+	colorEnabled = true
+
+	out    io.Writer = os.Stdout
+	errOut io.Writer = os.Stderr
 )
 
 func init() {
 	spinner.Set(spin.Spin1)
+
+	if os.Getenv("BITCOINX_LOG_FORMAT") == "json" {
+		SetFormat(FormatJSON)
+	}
 }
 
 // EnableColors enables or disable output coloring.
 func EnableColors(enabled bool) {
-	colorize.Disable = !enabled
+	colorEnabled = enabled
+	colorize.Disable = !colorEnabled || format == FormatJSON
+}
+
+// SetOutput sets the writer used for regular messages. Defaults to stdout.
+func SetOutput(w io.Writer) {
+	out = w
+}
+
+// SetErrorOutput sets the writer used for error messages. Defaults to stderr.
+func SetErrorOutput(w io.Writer) {
+	errOut = w
+}
+
+// SetLevel sets the verbosity level. Errors are printed at every level.
+func SetLevel(l Level) {
+	level = l
+}
+
+// SetFormat sets the output format. Colors are always disabled in JSON mode.
+func SetFormat(f Format) {
+	format = f
+	EnableColors(colorEnabled)
+}
+
+// output prints a message with the given level, using style in text mode.
+func output(w io.Writer, level, style, msg string, args ...interface{}) {
+	text := fmt.Sprintf(msg, args...)
+
+	if format == FormatJSON {
+		data, err := json.Marshal(struct {
+			Level     string `json:"level"`
+			Message   string `json:"message"`
+			Timestamp string `json:"timestamp"`
+		}{
+			Level:     level,
+			Message:   text,
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+		})
+		if err != nil {
+			return
+		}
+		fmt.Fprintln(w, string(data))
+		return
+	}
+
+	fmt.Fprintf(w, colorize.Color(style), text)
 }
 
 // Info prints an info message.
 func Info(msg string, args ...interface{}) {
-	fmt.Printf(colorize.Color("[bold][blue]==> [reset][bold]%s\n"), fmt.Sprintf(msg, args...))
+	if level < LevelNormal {
+		return
+	}
+	output(out, "info", "[bold][blue]==> [reset][bold]%s\n", msg, args...)
 }
 
 // Verbose prints a verbose message.
 func Verbose(msg string, args ...interface{}) {
-	fmt.Printf(colorize.Color("[dim]%s\n"), fmt.Sprintf(msg, args...))
+	if level < LevelVerbose {
+		return
+	}
+	output(out, "verbose", "[dim]%s\n", msg, args...)
+}
+
+// Debug prints a debug message.
+func Debug(msg string, args ...interface{}) {
+	if level < LevelDebug {
+		return
+	}
+	output(out, "debug", "[dim]%s\n", msg, args...)
 }
 
 // Success prints a success message.
 func Success(msg string, args ...interface{}) {
-	fmt.Printf(colorize.Color("[bold][green]✔[reset][bold] %s\n"), fmt.Sprintf(msg, args...))
+	if level < LevelNormal {
+		return
+	}
+	output(out, "success", "[bold][green]✔[reset][bold] %s\n", msg, args...)
+}
+
+// Warn prints a warning message.
+func Warn(msg string, args ...interface{}) {
+	if level < LevelNormal {
+		return
+	}
+	output(errOut, "warning", "[bold][yellow]![reset][bold] %s\n", msg, args...)
 }
 
 // Error prints an error message.
 func Error(msg string, args ...interface{}) {
-	fmt.Printf(colorize.Color("[bold][red]✗[reset][bold] %s\n"), fmt.Sprintf(msg, args...))
+	output(errOut, "error", "[bold][red]✗[reset][bold] %s\n", msg, args...)
 }
 
 // Fatal prints an error message and exits.
 func Fatal(msg string, args ...interface{}) {
-	Error(msg, args...)
+	output(errOut, "fatal", "[bold][red]✗[reset][bold] %s\n", msg, args...)
 	os.Exit(1)
 }
 
@@ -74,23 +181,76 @@ func ConsoleWidth() int {
 }
 
 // Live is used to print a live message. Subsequent calls will replace the line.
+// Live messages are not printed in JSON or quiet mode.
 func Live(msg string) {
+	if format == FormatJSON || level < LevelNormal {
+		return
+	}
+
 	// Format the message.
 	msg = fmt.Sprintf("%s %s", spinner.Next(), strings.TrimSpace(msg))
 
 	// Get the actual console width.
 	lineLength := ConsoleWidth()
 
-	// Shorten the message until it fits.
-	for utf8.RuneCountInString(msg) > lineLength {
-		msg = msg[0:len(msg)-4] + "…"
+	// Shorten the message until it fits, then pad it with spaces until it
+	// takes the entire line. This is in order to clear the previous line.
+	msg = truncate(msg, lineLength)
+	if pad := lineLength - displayWidth(msg); pad > 0 {
+		msg += strings.Repeat(" ", pad)
 	}
 
-	// Pad the message with spaces until it takes the entire line.
-	// This is in order to clear the previous line.
-	for utf8.RuneCountInString(msg) < lineLength {
-		msg = msg + " "
+	fmt.Fprintf(out, "%s\r", Small(msg))
+}
+
+// Progress is used to print a live progress bar of an operation processing
+// total bytes. Subsequent calls will replace the line.
+// If total is unknown (zero or less), a spinner is printed instead.
+func Progress(current, total int64, label string) {
+	if total <= 0 {
+		Live(fmt.Sprintf("%s (%s)", label, humanBytes(current)))
+		return
+	}
+	if format == FormatJSON || level < LevelNormal {
+		return
+	}
+	if current > total {
+		current = total
+	}
+
+	suffix := fmt.Sprintf(" %3d%% %s/%s", current*100/total, humanBytes(current), humanBytes(total))
+	lineLength := ConsoleWidth()
+
+	// Give the bar whatever space is left once the label and the suffix
+	// are printed.
+	barLength := lineLength - displayWidth(label) - displayWidth(suffix) - 3
+	if barLength < 10 {
+		Live(label + suffix)
+		return
+	}
+	filled := int(int64(barLength) * current / total)
+	bar := strings.Repeat("=", filled) + strings.Repeat(" ", barLength-filled)
+	if filled > 0 && filled < barLength {
+		bar = strings.Repeat("=", filled-1) + ">" + strings.Repeat(" ", barLength-filled)
 	}
 
-	fmt.Printf("%s\r", Small(msg))
+	msg := fmt.Sprintf("%s [%s]%s", label, bar, suffix)
+	if pad := lineLength - displayWidth(msg); pad > 0 {
+		msg += strings.Repeat(" ", pad)
+	}
+	fmt.Fprintf(out, "%s\r", Small(msg))
+}
+
+// humanBytes returns a human readable representation of a size in bytes.
+func humanBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
 }
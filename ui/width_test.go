@@ -0,0 +1,38 @@
+package ui
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		width int
+		want  string
+	}{
+		{"short", "hello", 10, "hello"},
+		{"exact", "hello", 5, "hello"},
+		{"ascii", "hello world", 8, "hello w…"},
+		{"cjk", "你好世界你好世界", 10, "你好世界…"},
+		{"cjk odd width", "你好世界你好世界", 9, "你好世界…"},
+		{"emoji", "🚀🚀🚀🚀🚀", 6, "🚀🚀…"},
+		{"mixed", "peer 節点 🚀 connected", 13, "peer 節点 🚀…"},
+		{"variation selector", "❤️❤️❤️❤️❤️❤️❤️", 4, "❤️❤️❤️…"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncate(tt.in, tt.width)
+			if got != tt.want {
+				t.Errorf("truncate(%q, %d) = %q, want %q", tt.in, tt.width, got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("truncate(%q, %d) = %q is not valid UTF-8", tt.in, tt.width, got)
+			}
+			if w := displayWidth(got); w > tt.width {
+				t.Errorf("truncate(%q, %d) is %d columns wide", tt.in, tt.width, w)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// prefixWriter prints every line written to it as a message tagged with
+// its prefix.
+type prefixWriter struct {
+	prefix string
+	buf    []byte
+	mu     sync.Mutex
+}
+
+// PrefixWriter returns a writer printing every line written to it, tagged
+// with a dim [tag]. This keeps the output of other processes apart from
+// ours. Lines are not printed in quiet mode. Close flushes the last line if
+// it doesn't end with a newline.
+func PrefixWriter(tag string) io.WriteCloser {
+	return &prefixWriter{
+		prefix: Small("[" + tag + "]"),
+	}
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		w.print(w.buf[:i])
+		w.buf = w.buf[i+1:]
+	}
+	return len(p), nil
+}
+
+func (w *prefixWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.buf) > 0 {
+		w.print(w.buf)
+		w.buf = nil
+	}
+	return nil
+}
+
+func (w *prefixWriter) print(line []byte) {
+	if level < LevelNormal {
+		return
+	}
+	output(out, "log", "%s\n", "%s %s", w.prefix, bytes.TrimRight(line, "\r"))
+}
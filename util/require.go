@@ -0,0 +1,28 @@
+package util
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+// installURLs tells users where to get the tools we depend on.
+var installURLs = map[string]string{
+	"docker": "https://docs.docker.com/install/",
+	"go":     "https://golang.org/doc/install",
+	"git":    "https://git-scm.com/downloads",
+}
+
+// RequireCommands makes sure the given commands can be found in PATH.
+// It returns an error describing how to install the first missing command.
+func RequireCommands(commands ...string) error {
+	for _, command := range commands {
+		if _, err := exec.LookPath(command); err == nil {
+			continue
+		}
+		if url, ok := installURLs[command]; ok {
+			return fmt.Errorf("%s not found in PATH, install it from %s", command, url)
+		}
+		return fmt.Errorf("%s not found in PATH", command)
+	}
+	return nil
+}
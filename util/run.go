@@ -1,13 +1,16 @@
 package util
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"os"
 	"os/exec"
 	"path"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -17,32 +20,73 @@ import (
 	"github.com/blocklayerhq/chainkit/ui"
 )
 
-// DockerRun runs a command within the project's container.
+// Timeouts of the docker commands expected to complete. The node and
+// explorer containers run until stopped and aren't bounded: their images are
+// pulled or loaded beforehand, within these timeouts.
+const (
+	PullTimeout = 30 * time.Minute
+	LoadTimeout = 10 * time.Minute
+	RunTimeout  = 5 * time.Minute
+)
+
+// DockerRun runs a one-off command within the project's container,
+// terminating it if it doesn't complete within RunTimeout.
 func DockerRun(ctx context.Context, config *config.Config, p *project.Project, args ...string) error {
-	return DockerRunWithFD(ctx, config, p, os.Stdin, os.Stdout, os.Stderr, args...)
+	return RunWithTimeout(ctx, RunTimeout, os.Stdin, os.Stdout, os.Stderr, "docker", dockerRunArgs(config, p, args)...)
 }
 
-// DockerRunWithFD is like DockerRun but accepts stdin/stdout/stderr.
+// DockerRunWithFD runs the project's container until it exits, with the
+// given stdin/stdout/stderr.
 func DockerRunWithFD(ctx context.Context, config *config.Config, p *project.Project, stdin io.Reader, stdout, stderr io.Writer, args ...string) error {
+	return RunWithFD(ctx, stdin, stdout, stderr, "docker", dockerRunArgs(config, p, args)...)
+}
+
+// dockerRunArgs returns the docker arguments running the project's daemon
+// with args.
+func dockerRunArgs(config *config.Config, p *project.Project, args []string) []string {
 	var (
 		daemonDirContainer = path.Join("/", "root", "."+p.Binaries.Daemon)
 		cliDirContainer    = path.Join("/", "root", "."+p.Binaries.CLI)
 	)
 
+	rpcPort := fmt.Sprintf("%d:26657", config.Ports.TendermintRPC)
+	if config.RPCBindAddress != "" {
+		rpcPort = net.JoinHostPort(config.RPCBindAddress, strconv.Itoa(config.Ports.TendermintRPC)) + ":26657"
+	}
+
 	cmd := []string{
 		"run", "--rm",
 		"-p", fmt.Sprintf("%d:26656", config.Ports.TendermintP2P),
-		"-p", fmt.Sprintf("%d:26657", config.Ports.TendermintRPC),
+		"-p", rpcPort,
 		"-v", config.StateDir() + ":" + daemonDirContainer,
 		"-v", config.CLIDir() + ":" + cliDirContainer,
 		"-l", "chainkit.cosmos.daemon",
 		"-l", "chainkit.project=" + p.Name,
-		p.Image + ":latest",
-		p.Binaries.Daemon,
+		"-l", DockerRootLabel(config),
 	}
-	cmd = append(cmd, args...)
+	cmd = append(cmd, DockerResourceFlags(config)...)
+	cmd = append(cmd, p.Image+":latest", p.Binaries.Daemon)
+	return append(cmd, args...)
+}
 
-	return RunWithFD(ctx, stdin, stdout, stderr, "docker", cmd...)
+// DockerRootLabel returns the label of the containers started for the node
+// in config.RootDir. Networks joined from the same application share the
+// project name, the root directory tells them apart.
+func DockerRootLabel(config *config.Config) string {
+	return "bitcoinx.root=" + config.RootDir
+}
+
+// DockerResourceFlags returns the docker run flags enforcing the configured
+// resource limits.
+func DockerResourceFlags(config *config.Config) []string {
+	flags := []string{}
+	if config.MemoryLimit != "" {
+		flags = append(flags, "--memory", config.MemoryLimit)
+	}
+	if config.CPULimit != "" {
+		flags = append(flags, "--cpus", config.CPULimit)
+	}
+	return flags
 }
 
 // DockerLoad loads an image into docker from an io.Reader
@@ -50,7 +94,7 @@ func DockerLoad(ctx context.Context, image io.Reader) error {
 	errCh := make(chan error)
 	go func() {
 		defer close(errCh)
-		errCh <- RunWithFD(ctx, image, ioutil.Discard, ioutil.Discard, "docker", "load", "-q")
+		errCh <- RunWithTimeout(ctx, LoadTimeout, image, ioutil.Discard, ioutil.Discard, "docker", "load", "-q")
 	}()
 
 	msg := "Loading image"
@@ -71,6 +115,35 @@ func Run(ctx context.Context, command string, args ...string) error {
 	return RunWithFD(ctx, os.Stdin, os.Stdout, os.Stderr, command, args...)
 }
 
+// RunWithTimeout is like RunWithFD, but terminates the command if it doesn't
+// complete within timeout.
+func RunWithTimeout(ctx context.Context, timeout time.Duration, stdin io.Reader, stdout, stderr io.Writer, command string, args ...string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	err := RunWithFD(ctx, stdin, stdout, stderr, command, args...)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		name := command
+		if len(args) > 0 {
+			name += " " + args[0]
+		}
+		return fmt.Errorf("%s timed out after %v", name, timeout)
+	}
+	return err
+}
+
+// Output runs a system command and returns its standard output. If the
+// command fails, the error includes what it wrote to stderr.
+func Output(ctx context.Context, command string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	if err := RunWithFD(ctx, nil, &stdout, &stderr, command, args...); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return stdout.Bytes(), fmt.Errorf("%v: %s", err, msg)
+		}
+		return stdout.Bytes(), err
+	}
+	return stdout.Bytes(), nil
+}
+
 // RunWithFD is like Run, but accepts custom stdin/stdout/stderr.
 func RunWithFD(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer, command string, args ...string) error {
 	cmd := exec.Command(command)
@@ -79,10 +152,27 @@ func RunWithFD(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer, c
 	cmd.Stdout = stdout
 	cmd.Stderr = stderr
 
+	// Commands not attached to a terminal run in their own process group,
+	// so cancelling also reaches the processes they spawned. Commands on a
+	// terminal must stay in the foreground group to use it, and already
+	// receive the terminal signals.
+	group := !isTerminal(stdin)
+	if group {
+		cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	}
+
 	if err := cmd.Start(); err != nil {
 		return err
 	}
 
+	signal := func(sig syscall.Signal) {
+		if group {
+			syscall.Kill(-cmd.Process.Pid, sig)
+			return
+		}
+		cmd.Process.Signal(sig)
+	}
+
 	// We don't use exec.CommandContext here because it will
 	// SIGKILL the process. Instead, we handle the context
 	// on our own and try to gracefully shutdown the command.
@@ -90,10 +180,10 @@ func RunWithFD(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer, c
 	go func() {
 		select {
 		case <-ctx.Done():
-			cmd.Process.Signal(syscall.SIGTERM)
+			signal(syscall.SIGTERM)
 			select {
 			case <-time.After(5 * time.Second):
-				cmd.Process.Kill()
+				signal(syscall.SIGKILL)
 			case <-waitDone:
 			}
 		case <-waitDone:
@@ -104,3 +194,16 @@ func RunWithFD(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer, c
 	close(waitDone)
 	return err
 }
+
+// isTerminal returns true if r is a terminal.
+func isTerminal(r io.Reader) bool {
+	f, ok := r.(*os.File)
+	if !ok {
+		return false
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
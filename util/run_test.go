@@ -0,0 +1,24 @@
+package util
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunWithTimeout(t *testing.T) {
+	start := time.Now()
+	err := RunWithTimeout(context.Background(), 100*time.Millisecond, nil, ioutil.Discard, ioutil.Discard, "sleep", "10")
+	if err == nil || !strings.Contains(err.Error(), "sleep 10 timed out") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("command terminated after %v", elapsed)
+	}
+
+	if err := RunWithTimeout(context.Background(), time.Minute, nil, ioutil.Discard, ioutil.Discard, "true"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -3,3 +3,11 @@ package version
 // Version is the binary version.
 // This will be populated during build.
 var Version = "unknown"
+
+// Commit is the git commit the binary was built from.
+// This will be populated during build.
+var Commit = "unknown"
+
+// BuildDate is the date the binary was built at.
+// This will be populated during build.
+var BuildDate = "unknown"